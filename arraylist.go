@@ -5,13 +5,14 @@ import "sort"
 // ArrayList is a generic type that holds a collection of items of any type.
 type ArrayList[T any] struct {
 	collection []T
+	comparer   EqualityComparer[T]
 }
 
 // NewArrayList initializes a new empty ArrayList.
 //
 // Example:
 //  list := NewArrayList[int]()
-//  fmt.Println(list) // Output: &{[]}
+//  fmt.Println(list.Items()) // Output: []
 func NewArrayList[T any]() *ArrayList[T] {
 	return &ArrayList[T]{
 		collection: []T{},
@@ -22,7 +23,7 @@ func NewArrayList[T any]() *ArrayList[T] {
 //
 // Example:
 //  list := NewArrayListT(1, 2, 3, 4, 5, 6)
-//  fmt.Println(list) // Output: &{[1 2 3 4 5 6]}
+//  fmt.Println(list.Items()) // Output: [1 2 3 4 5 6]
 func NewArrayListT[T any](items ...T) *ArrayList[T] {
 	l := ArrayList[T]{
 		collection: make([]T, len(items)),
@@ -34,6 +35,18 @@ func NewArrayListT[T any](items ...T) *ArrayList[T] {
 	return &l
 }
 
+// NewArrayListWithComparer initializes a new ArrayList with the given items that compares items with comparer
+// whenever GetIndex or Remove is called without an equal function, and in IndexOf and Contains.
+//
+// Example:
+//  list := NewArrayListWithComparer(BytesComparer(), []byte("a"), []byte("b"))
+//  fmt.Println(list.IndexOf([]byte("b"))) // Output: 1
+func NewArrayListWithComparer[T any](comparer EqualityComparer[T], items ...T) *ArrayList[T] {
+	l := NewArrayListT(items...)
+	l.comparer = comparer
+	return l
+}
+
 // Count returns the number of items in the ArrayList.
 //
 // Example:
@@ -48,7 +61,7 @@ func (l *ArrayList[T]) Count() int {
 // Example:
 //  list := NewArrayListT(1, 2, 3)
 //  list.Clear()
-//  fmt.Println(list) // Output: &{[]}
+//  fmt.Println(list.Items()) // Output: []
 func (l *ArrayList[T]) Clear() {
 	l.collection = []T{}
 }
@@ -68,7 +81,7 @@ func (l *ArrayList[T]) Items() []T {
 // Example:
 //  list := NewArrayList[int]()
 //  list.Add(1)
-//  fmt.Println(list) // Output: &{[1]}
+//  fmt.Println(list.Items()) // Output: [1]
 func (l *ArrayList[T]) Add(item T) {
 	l.collection = append(l.collection, item)
 }
@@ -78,7 +91,7 @@ func (l *ArrayList[T]) Add(item T) {
 // Example:
 //  list := NewArrayList[int]()
 //  list.AddRange([]int{1, 2, 3})
-//  fmt.Println(list) // Output: &{[1 2 3]}
+//  fmt.Println(list.Items()) // Output: [1 2 3]
 func (l *ArrayList[T]) AddRange(items []T) {
 	l.collection = append(l.collection, items...)
 }
@@ -98,33 +111,49 @@ func (l *ArrayList[T]) Get(index int) T {
 
 // GetIndex retrieves the index of the specified item in the ArrayList.
 // Returns -1 if the item is not found.
+// If equal is nil, the ArrayList's comparer is used instead.
 //
 // Example:
 //  list := NewArrayListT(1, 2, 3)
 //  index := list.GetIndex(2, func(x int) bool { return x == 2 })
 //  fmt.Println(index) // Output: 1
 func (l *ArrayList[T]) GetIndex(item T, equal func(T, T) bool) int {
+	comparer := l.comparer
+	if equal != nil {
+		comparer = EqualityFunc[T](equal)
+	}
 	for i, v := range l.collection {
-		if equal(v, item) {
+		if equalValues(comparer, v, item) {
 			return i
 		}
 	}
 	return -1
 }
 
+// IndexOf retrieves the index of the specified item using the ArrayList's comparer.
+// Returns -1 if the item is not found.
+// Without a comparer, items are compared with ==, which panics for non-comparable types.
+func (l *ArrayList[T]) IndexOf(item T) int {
+	return l.GetIndex(item, nil)
+}
+
+// Contains checks if the ArrayList contains the specified item, using the ArrayList's comparer.
+func (l *ArrayList[T]) Contains(item T) bool {
+	return l.GetIndex(item, nil) >= 0
+}
+
 // Remove removes the first occurrence of the specified item from the ArrayList.
+// If equal is nil, the ArrayList's comparer is used instead.
 //
 // Example:
 //  list := NewArrayListT(1, 2, 3)
 //  removed := list.Remove(2, func(x, y int) bool { return x == y })
-//  fmt.Println(removed) // Output: true
-//  fmt.Println(list)    // Output: &{[1 3]}
+//  fmt.Println(removed)      // Output: true
+//  fmt.Println(list.Items()) // Output: [1 3]
 func (l *ArrayList[T]) Remove(item T, equal func(T, T) bool) bool {
-	for i, v := range l.collection {
-		if equal(v, item) {
-			l.collection = append(l.collection[:i], l.collection[i+1:]...)
-			return true
-		}
+	if i := l.GetIndex(item, equal); i >= 0 {
+		l.collection = append(l.collection[:i], l.collection[i+1:]...)
+		return true
 	}
 	return false
 }
@@ -134,7 +163,7 @@ func (l *ArrayList[T]) Remove(item T, equal func(T, T) bool) bool {
 // Example:
 //  list := NewArrayListT(1, 2, 3)
 //  list.RemoveAt(1)
-//  fmt.Println(list) // Output: &{[1 3]}
+//  fmt.Println(list.Items()) // Output: [1 3]
 func (l *ArrayList[T]) RemoveAt(index int) {
 	if index < 0 || index >= l.Count() {
 		panic("Index out of range.")
@@ -148,7 +177,7 @@ func (l *ArrayList[T]) RemoveAt(index int) {
 // Example:
 //  list := NewArrayListT(1, 2, 3)
 //  list.Set(1, 10)
-//  fmt.Println(list) // Output: &{[1 10 3]}
+//  fmt.Println(list.Items()) // Output: [1 10 3]
 func (l *ArrayList[T]) Set(index int, item T) {
 	if index < 0 || index >= l.Count() {
 		panic("Index out of range.")
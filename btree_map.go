@@ -0,0 +1,590 @@
+package collections
+
+import (
+	"cmp"
+	"sync/atomic"
+)
+
+// defaultBTreeDegree is the degree of a BTreeMap created with a degree of 0.
+const defaultBTreeDegree = 32
+
+// BTreeMap is an ordered map backed by a B-tree. Every node holds between degree-1 and 2*degree-1
+// entries in one contiguous slice, so lookups touch few cache lines and the tree stays shallow
+// even for tens of millions of keys.
+//
+// Nodes are shared copy-on-write, so Clone is O(1) and the clone and the original
+// only copy the nodes on the paths they modify afterwards. Clone only reads the map, so it may be called
+// concurrently with other reads, and clones can be handed to other goroutines.
+type BTreeMap[K any, V any] struct {
+	root   *btreeNode[K, V]
+	size   int
+	degree int
+	less   func(a, b K) bool
+	// gen identifies the nodes this map owns and may modify in place; all others are copied first.
+	// Only the writer changes it.
+	gen uint64
+	// shared is set when Clone makes the current nodes visible to another map, so that the next change
+	// takes a new generation without Clone writing to gen.
+	shared atomic.Bool
+}
+
+type btreeNode[K any, V any] struct {
+	items []btreeItem[K, V]
+	// children is empty for leaves and holds len(items)+1 subtrees otherwise.
+	children []*btreeNode[K, V]
+	gen      uint64
+}
+
+type btreeItem[K any, V any] struct {
+	key   K
+	value V
+}
+
+// NewBTreeMap creates a new empty BTreeMap ordered by the natural order of K.
+// A degree of 0 selects a default suited to in-memory use.
+//
+// Example:
+//  m := NewBTreeMap[int, string](0)
+//  m.Set(2, "two")
+//  m.Set(1, "one")
+//  fmt.Println(m.Keys()) // Output: [1 2]
+func NewBTreeMap[K cmp.Ordered, V any](degree int) *BTreeMap[K, V] {
+	return NewBTreeMapFunc[K, V](degree, cmp.Less[K])
+}
+
+// NewBTreeMapFunc creates a new empty BTreeMap ordered by less.
+// It panics if degree is not 0 and is less than 2.
+func NewBTreeMapFunc[K any, V any](degree int, less func(a, b K) bool) *BTreeMap[K, V] {
+	if degree == 0 {
+		degree = defaultBTreeDegree
+	}
+	if degree < 2 {
+		panic("Degree must be at least 2.")
+	}
+	return &BTreeMap[K, V]{degree: degree, less: less, gen: cowGeneration.Add(1)}
+}
+
+func (m *BTreeMap[K, V]) maxItems() int {
+	return 2*m.degree - 1
+}
+
+func (m *BTreeMap[K, V]) minItems() int {
+	return m.degree - 1
+}
+
+func (m *BTreeMap[K, V]) newNode() *btreeNode[K, V] {
+	return &btreeNode[K, V]{items: make([]btreeItem[K, V], 0, m.maxItems()), gen: m.gen}
+}
+
+// own takes a new generation before a change if the nodes have been shared since the last one,
+// so that they are copied instead of modified in place.
+func (m *BTreeMap[K, V]) own() {
+	if m.shared.Load() {
+		m.gen = cowGeneration.Add(1)
+		m.shared.Store(false)
+	}
+}
+
+// writable returns n if the map owns it, otherwise a copy of n owned by the map.
+func (m *BTreeMap[K, V]) writable(n *btreeNode[K, V]) *btreeNode[K, V] {
+	if n.gen == m.gen {
+		return n
+	}
+	c := m.newNode()
+	c.items = append(c.items, n.items...)
+	if len(n.children) > 0 {
+		c.children = make([]*btreeNode[K, V], len(n.children), m.maxItems()+1)
+		copy(c.children, n.children)
+	}
+	return c
+}
+
+// find returns the index of the first item in n whose key is not less than key, and whether it equals key.
+func (m *BTreeMap[K, V]) find(n *btreeNode[K, V], key K) (int, bool) {
+	lo, hi := 0, len(n.items)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if m.less(n.items[mid].key, key) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(n.items) && !m.less(key, n.items[lo].key)
+}
+
+// Get retrieves the value for the given key.
+func (m *BTreeMap[K, V]) Get(key K) (V, bool) {
+	for n := m.root; n != nil; {
+		i, found := m.find(n, key)
+		if found {
+			return n.items[i].value, true
+		}
+		if len(n.children) == 0 {
+			break
+		}
+		n = n.children[i]
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// ContainsKey checks if the given key is present.
+func (m *BTreeMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Set adds or updates the value for the given key.
+func (m *BTreeMap[K, V]) Set(key K, value V) {
+	m.own()
+	if m.root == nil {
+		m.root = m.newNode()
+		m.root.items = append(m.root.items, btreeItem[K, V]{key: key, value: value})
+		m.size = 1
+		return
+	}
+
+	m.root = m.writable(m.root)
+	if len(m.root.items) == m.maxItems() {
+		old := m.root
+		m.root = m.newNode()
+		m.root.children = append(make([]*btreeNode[K, V], 0, m.maxItems()+1), old)
+		m.splitChild(m.root, 0)
+	}
+	if m.insert(m.root, key, value) {
+		m.size++
+	}
+}
+
+// insert adds key to the subtree of the writable, non-full node n and reports whether it was new.
+func (m *BTreeMap[K, V]) insert(n *btreeNode[K, V], key K, value V) bool {
+	for {
+		i, found := m.find(n, key)
+		if found {
+			n.items[i].value = value
+			return false
+		}
+		if len(n.children) == 0 {
+			n.items = append(n.items, btreeItem[K, V]{})
+			copy(n.items[i+1:], n.items[i:])
+			n.items[i] = btreeItem[K, V]{key: key, value: value}
+			return true
+		}
+
+		n.children[i] = m.writable(n.children[i])
+		if len(n.children[i].items) == m.maxItems() {
+			m.splitChild(n, i)
+			// The child's median moved up to n.items[i].
+			if !m.less(key, n.items[i].key) {
+				if !m.less(n.items[i].key, key) {
+					n.items[i].value = value
+					return false
+				}
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// splitChild splits the full, writable child i of the writable node n around its median,
+// which moves up into n.
+func (m *BTreeMap[K, V]) splitChild(n *btreeNode[K, V], i int) {
+	child := n.children[i]
+	mid := m.degree - 1
+	median := child.items[mid]
+
+	right := m.newNode()
+	right.items = append(right.items, child.items[mid+1:]...)
+	clear(child.items[mid:])
+	child.items = child.items[:mid]
+	if len(child.children) > 0 {
+		right.children = make([]*btreeNode[K, V], 0, m.maxItems()+1)
+		right.children = append(right.children, child.children[mid+1:]...)
+		clear(child.children[mid+1:])
+		child.children = child.children[:mid+1]
+	}
+
+	n.items = append(n.items, btreeItem[K, V]{})
+	copy(n.items[i+1:], n.items[i:])
+	n.items[i] = median
+	n.children = append(n.children, nil)
+	copy(n.children[i+2:], n.children[i+1:])
+	n.children[i+1] = right
+}
+
+// Remove removes the given key.
+// Returns true if the key was removed, false if it was not present.
+func (m *BTreeMap[K, V]) Remove(key K) bool {
+	m.own()
+	if m.root == nil {
+		return false
+	}
+
+	m.root = m.writable(m.root)
+	removed := m.remove(m.root, key)
+	if len(m.root.items) == 0 {
+		if len(m.root.children) == 0 {
+			m.root = nil
+		} else {
+			m.root = m.root.children[0]
+		}
+	}
+	if removed {
+		m.size--
+	}
+	return removed
+}
+
+// remove deletes key from the subtree of the writable node n, which holds more than the minimum
+// number of items unless it is the root.
+func (m *BTreeMap[K, V]) remove(n *btreeNode[K, V], key K) bool {
+	for {
+		i, found := m.find(n, key)
+		if len(n.children) == 0 {
+			if !found {
+				return false
+			}
+			m.removeItem(n, i)
+			return true
+		}
+
+		if found {
+			switch {
+			case len(n.children[i].items) > m.minItems():
+				n.children[i] = m.writable(n.children[i])
+				n.items[i] = m.removeMax(n.children[i])
+			case len(n.children[i+1].items) > m.minItems():
+				n.children[i+1] = m.writable(n.children[i+1])
+				n.items[i] = m.removeMin(n.children[i+1])
+			default:
+				// Both neighbours are minimal, so merge them around the key and delete it from the result.
+				m.merge(n, i)
+				n = n.children[i]
+				continue
+			}
+			return true
+		}
+
+		n = n.children[m.growChild(n, i)]
+	}
+}
+
+func (m *BTreeMap[K, V]) removeItem(n *btreeNode[K, V], i int) btreeItem[K, V] {
+	item := n.items[i]
+	last := len(n.items) - 1
+	copy(n.items[i:], n.items[i+1:])
+	n.items[last] = btreeItem[K, V]{}
+	n.items = n.items[:last]
+	return item
+}
+
+// removeMax removes and returns the largest item below the writable node n.
+func (m *BTreeMap[K, V]) removeMax(n *btreeNode[K, V]) btreeItem[K, V] {
+	for len(n.children) > 0 {
+		n = n.children[m.growChild(n, len(n.children)-1)]
+	}
+	return m.removeItem(n, len(n.items)-1)
+}
+
+// removeMin removes and returns the smallest item below the writable node n.
+func (m *BTreeMap[K, V]) removeMin(n *btreeNode[K, V]) btreeItem[K, V] {
+	for len(n.children) > 0 {
+		n = n.children[m.growChild(n, 0)]
+	}
+	return m.removeItem(n, 0)
+}
+
+// growChild makes child i of the writable node n writable and ensures it holds more than the minimum
+// number of items, by borrowing from a sibling or merging with one. It returns the child's new index.
+func (m *BTreeMap[K, V]) growChild(n *btreeNode[K, V], i int) int {
+	n.children[i] = m.writable(n.children[i])
+	child := n.children[i]
+	if len(child.items) > m.minItems() {
+		return i
+	}
+
+	if i > 0 && len(n.children[i-1].items) > m.minItems() {
+		left := m.writable(n.children[i-1])
+		n.children[i-1] = left
+		child.items = append(child.items, btreeItem[K, V]{})
+		copy(child.items[1:], child.items)
+		child.items[0] = n.items[i-1]
+		n.items[i-1] = m.removeItem(left, len(left.items)-1)
+		if len(left.children) > 0 {
+			last := len(left.children) - 1
+			child.children = append(child.children, nil)
+			copy(child.children[1:], child.children)
+			child.children[0] = left.children[last]
+			left.children[last] = nil
+			left.children = left.children[:last]
+		}
+		return i
+	}
+
+	if i < len(n.children)-1 && len(n.children[i+1].items) > m.minItems() {
+		right := m.writable(n.children[i+1])
+		n.children[i+1] = right
+		child.items = append(child.items, n.items[i])
+		n.items[i] = m.removeItem(right, 0)
+		if len(right.children) > 0 {
+			child.children = append(child.children, right.children[0])
+			last := len(right.children) - 1
+			copy(right.children, right.children[1:])
+			right.children[last] = nil
+			right.children = right.children[:last]
+		}
+		return i
+	}
+
+	if i == len(n.children)-1 {
+		i--
+	}
+	m.merge(n, i)
+	return i
+}
+
+// merge joins child i+1 of the writable node n and the item between them into child i.
+func (m *BTreeMap[K, V]) merge(n *btreeNode[K, V], i int) {
+	n.children[i] = m.writable(n.children[i])
+	left, right := n.children[i], n.children[i+1]
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+
+	m.removeItem(n, i)
+	last := len(n.children) - 1
+	copy(n.children[i+1:], n.children[i+2:])
+	n.children[last] = nil
+	n.children = n.children[:last]
+}
+
+// Count returns the number of keys in the map.
+func (m *BTreeMap[K, V]) Count() int {
+	return m.size
+}
+
+// Clear removes all keys from the map. Clones taken earlier are unaffected.
+func (m *BTreeMap[K, V]) Clear() {
+	m.own()
+	m.root = nil
+	m.size = 0
+}
+
+// Clone returns an independent copy of the map in O(1).
+// Both maps share their nodes until either is modified, and then copy only the nodes on the modified paths.
+//
+// Example:
+//  snapshot := m.Clone()
+//  m.Set(3, "three")
+//  fmt.Println(m.Count(), snapshot.Count()) // Output: 3 2
+func (m *BTreeMap[K, V]) Clone() *BTreeMap[K, V] {
+	// Neither map may modify the shared nodes in place any more: the clone starts with a new generation,
+	// and m takes one on its next change.
+	m.shared.Store(true)
+	return &BTreeMap[K, V]{root: m.root, size: m.size, degree: m.degree, less: m.less, gen: cowGeneration.Add(1)}
+}
+
+// Min returns the smallest key and its value.
+// The boolean result is false if the map is empty.
+func (m *BTreeMap[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	n := m.root
+	for len(n.children) > 0 {
+		n = n.children[0]
+	}
+	return n.items[0].key, n.items[0].value, true
+}
+
+// Max returns the largest key and its value.
+// The boolean result is false if the map is empty.
+func (m *BTreeMap[K, V]) Max() (K, V, bool) {
+	if m.root == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	n := m.root
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	item := n.items[len(n.items)-1]
+	return item.key, item.value, true
+}
+
+// Ascend calls fn for every key in ascending order until fn returns false.
+func (m *BTreeMap[K, V]) Ascend(fn func(key K, value V) bool) {
+	if m.root != nil {
+		m.ascend(m.root, nil, nil, fn)
+	}
+}
+
+// AscendRange calls fn in ascending order for every key in the half-open range [from, to)
+// until fn returns false.
+//
+// Example:
+//  m.AscendRange(10, 20, func(k int, v string) bool {
+//  	fmt.Println(k, v)
+//  	return true
+//  })
+func (m *BTreeMap[K, V]) AscendRange(from, to K, fn func(key K, value V) bool) {
+	if m.root != nil {
+		m.ascend(m.root, &from, &to, fn)
+	}
+}
+
+// AscendFrom calls fn in ascending order for every key greater than or equal to from
+// until fn returns false.
+func (m *BTreeMap[K, V]) AscendFrom(from K, fn func(key K, value V) bool) {
+	if m.root != nil {
+		m.ascend(m.root, &from, nil, fn)
+	}
+}
+
+// ascend visits the keys of n's subtree within the optional bounds and reports whether to continue.
+func (m *BTreeMap[K, V]) ascend(n *btreeNode[K, V], from, to *K, fn func(key K, value V) bool) bool {
+	start := 0
+	if from != nil {
+		start, _ = m.find(n, *from)
+	}
+	for i := start; i <= len(n.items); i++ {
+		if len(n.children) > 0 && !m.ascend(n.children[i], from, to, fn) {
+			return false
+		}
+		// Only the leftmost descent can reach keys below from.
+		from = nil
+		if i == len(n.items) {
+			break
+		}
+		item := &n.items[i]
+		if to != nil && !m.less(item.key, *to) {
+			return false
+		}
+		if !fn(item.key, item.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Descend calls fn for every key in descending order until fn returns false.
+func (m *BTreeMap[K, V]) Descend(fn func(key K, value V) bool) {
+	if m.root != nil {
+		m.descend(m.root, fn)
+	}
+}
+
+func (m *BTreeMap[K, V]) descend(n *btreeNode[K, V], fn func(key K, value V) bool) bool {
+	for i := len(n.items); i >= 0; i-- {
+		if len(n.children) > 0 && !m.descend(n.children[i], fn) {
+			return false
+		}
+		if i > 0 && !fn(n.items[i-1].key, n.items[i-1].value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys returns a slice of all keys in ascending order.
+func (m *BTreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.Ascend(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice of all values in ascending order of their keys.
+func (m *BTreeMap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	m.Ascend(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// BulkLoad replaces the contents of the map with keys and their values in O(n),
+// packing nodes fully instead of leaving the half-empty nodes that repeated Set calls produce.
+// It panics if keys is not strictly ascending or if keys and values differ in length.
+//
+// Example:
+//  m := NewBTreeMap[int, string](0)
+//  m.BulkLoad([]int{1, 2, 3}, []string{"a", "b", "c"})
+func (m *BTreeMap[K, V]) BulkLoad(keys []K, values []V) {
+	if len(keys) != len(values) {
+		panic("Keys and values must have the same length.")
+	}
+	m.Clear()
+	if len(keys) == 0 {
+		return
+	}
+
+	// spine is the path from the root to the rightmost leaf; every node left of it is full.
+	spine := []*btreeNode[K, V]{m.newNode()}
+	for i, key := range keys {
+		if i > 0 && !m.less(keys[i-1], key) {
+			panic("Keys must be sorted in strictly ascending order.")
+		}
+		item := btreeItem[K, V]{key: key, value: values[i]}
+
+		level := len(spine) - 1
+		for level >= 0 && len(spine[level].items) == m.maxItems() {
+			level--
+		}
+		if level < 0 {
+			root := m.newNode()
+			root.children = append(make([]*btreeNode[K, V], 0, m.maxItems()+1), spine[0])
+			spine = append([]*btreeNode[K, V]{root}, spine...)
+			level = 0
+		}
+		spine[level].items = append(spine[level].items, item)
+		// The item became a separator, so open a fresh right edge below it.
+		for l := level + 1; l < len(spine); l++ {
+			fresh := m.newNode()
+			if l < len(spine)-1 {
+				fresh.children = make([]*btreeNode[K, V], 0, m.maxItems()+1)
+			}
+			spine[l-1].children = append(spine[l-1].children, fresh)
+			spine[l] = fresh
+		}
+	}
+
+	// The nodes on the right edge may be underfull; refill each from its full left sibling.
+	for l := 1; l < len(spine); l++ {
+		parent, n := spine[l-1], spine[l]
+		if len(n.items) < m.minItems() {
+			m.rebalanceRight(parent, len(parent.children)-2)
+		}
+	}
+	m.root = spine[0]
+	m.size = len(keys)
+}
+
+// rebalanceRight evens out child i+1 of n, which is underfull, with its full left sibling i.
+func (m *BTreeMap[K, V]) rebalanceRight(n *btreeNode[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	items := make([]btreeItem[K, V], 0, len(left.items)+1+len(right.items))
+	items = append(items, left.items...)
+	items = append(items, n.items[i])
+	items = append(items, right.items...)
+	children := make([]*btreeNode[K, V], 0, len(left.children)+len(right.children))
+	children = append(children, left.children...)
+	children = append(children, right.children...)
+
+	split := (len(items) - 1) - (len(items)-1)/2
+	left.items = append(make([]btreeItem[K, V], 0, m.maxItems()), items[:split]...)
+	n.items[i] = items[split]
+	right.items = append(make([]btreeItem[K, V], 0, m.maxItems()), items[split+1:]...)
+	if len(children) > 0 {
+		left.children = append(make([]*btreeNode[K, V], 0, m.maxItems()+1), children[:split+1]...)
+		right.children = append(make([]*btreeNode[K, V], 0, m.maxItems()+1), children[split+1:]...)
+	}
+}
@@ -0,0 +1,261 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// checkBTree verifies the B-tree invariants: sorted keys, node sizes within bounds
+// and every leaf at the same depth.
+func checkBTree[K any, V any](t *testing.T, m *BTreeMap[K, V]) {
+	t.Helper()
+	if m.root == nil {
+		if m.size != 0 {
+			t.Fatalf("empty tree has size %d", m.size)
+		}
+		return
+	}
+
+	leafDepth := -1
+	count := 0
+	var walk func(n *btreeNode[K, V], depth int, isRoot bool)
+	walk = func(n *btreeNode[K, V], depth int, isRoot bool) {
+		if len(n.items) > m.maxItems() || (!isRoot && len(n.items) < m.minItems()) || len(n.items) == 0 {
+			t.Fatalf("node at depth %d holds %d items", depth, len(n.items))
+		}
+		for i := 1; i < len(n.items); i++ {
+			if !m.less(n.items[i-1].key, n.items[i].key) {
+				t.Fatalf("node items out of order at depth %d", depth)
+			}
+		}
+		count += len(n.items)
+		if len(n.children) == 0 {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				t.Fatalf("leaves at depths %d and %d", leafDepth, depth)
+			}
+			return
+		}
+		if len(n.children) != len(n.items)+1 {
+			t.Fatalf("node with %d items has %d children", len(n.items), len(n.children))
+		}
+		for _, child := range n.children {
+			walk(child, depth+1, false)
+		}
+	}
+	walk(m.root, 0, true)
+	if count != m.size {
+		t.Fatalf("tree holds %d items; Count() = %d", count, m.size)
+	}
+}
+
+func TestBTreeMap(t *testing.T) {
+	m := NewBTreeMap[int, string](2)
+	for _, k := range []int{5, 1, 9, 3, 7, 2, 8} {
+		m.Set(k, "v")
+	}
+	m.Set(3, "three")
+	checkBTree(t, m)
+
+	assertSliceEqual(t, m.Keys(), []int{1, 2, 3, 5, 7, 8, 9})
+	if v, ok := m.Get(3); !ok || v != "three" {
+		t.Errorf("Get(3) = %q, %v; want three, true", v, ok)
+	}
+	if m.ContainsKey(4) {
+		t.Error("ContainsKey(4) = true; want false")
+	}
+	if k, _, _ := m.Min(); k != 1 {
+		t.Errorf("Min() = %d; want 1", k)
+	}
+	if k, _, _ := m.Max(); k != 9 {
+		t.Errorf("Max() = %d; want 9", k)
+	}
+
+	var scanned []int
+	m.AscendRange(3, 8, func(k int, _ string) bool {
+		scanned = append(scanned, k)
+		return true
+	})
+	assertSliceEqual(t, scanned, []int{3, 5, 7})
+
+	scanned = nil
+	m.AscendFrom(4, func(k int, _ string) bool {
+		scanned = append(scanned, k)
+		return len(scanned) < 2
+	})
+	assertSliceEqual(t, scanned, []int{5, 7})
+
+	scanned = nil
+	m.Descend(func(k int, _ string) bool {
+		scanned = append(scanned, k)
+		return true
+	})
+	assertSliceEqual(t, scanned, []int{9, 8, 7, 5, 3, 2, 1})
+
+	if !m.Remove(5) || m.Remove(5) {
+		t.Error("Remove(5) twice did not return true, false")
+	}
+	checkBTree(t, m)
+	if count := m.Count(); count != 6 {
+		t.Errorf("Count() = %d; want 6", count)
+	}
+
+	m.Clear()
+	if _, _, ok := m.Min(); ok || m.Count() != 0 {
+		t.Error("Clear() left keys behind")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a degree of 1, but did not panic")
+		}
+	}()
+	NewBTreeMap[int, int](1)
+}
+
+func TestBTreeMap_RandomOperations(t *testing.T) {
+	for _, degree := range []int{2, 3, 16} {
+		r := rand.New(rand.NewSource(int64(degree)))
+		m := NewBTreeMap[int, int](degree)
+		reference := map[int]int{}
+		var clone *BTreeMap[int, int]
+		var cloneRef map[int]int
+
+		for i := 0; i < 20000; i++ {
+			k := r.Intn(2000)
+			if r.Intn(2) == 0 {
+				m.Set(k, i)
+				reference[k] = i
+			} else {
+				_, existed := reference[k]
+				if m.Remove(k) != existed {
+					t.Fatalf("degree %d: Remove(%d) != %v", degree, k, existed)
+				}
+				delete(reference, k)
+			}
+			if i == 10000 {
+				clone = m.Clone()
+				cloneRef = make(map[int]int, len(reference))
+				for k, v := range reference {
+					cloneRef[k] = v
+				}
+			}
+		}
+
+		for _, c := range []struct {
+			m   *BTreeMap[int, int]
+			ref map[int]int
+		}{{m, reference}, {clone, cloneRef}} {
+			checkBTree(t, c.m)
+			want := make([]int, 0, len(c.ref))
+			for k := range c.ref {
+				want = append(want, k)
+			}
+			sort.Ints(want)
+			assertSliceEqual(t, c.m.Keys(), want)
+			for k, v := range c.ref {
+				if got, _ := c.m.Get(k); got != v {
+					t.Fatalf("degree %d: Get(%d) = %d; want %d", degree, k, got, v)
+				}
+			}
+		}
+	}
+}
+
+func TestBTreeMap_BulkLoad(t *testing.T) {
+	for _, degree := range []int{2, 3, 5} {
+		for n := 0; n < 300; n++ {
+			keys := make([]int, n)
+			values := make([]int, n)
+			for i := range keys {
+				keys[i], values[i] = 2*i, i
+			}
+			m := NewBTreeMap[int, int](degree)
+			m.BulkLoad(keys, values)
+			checkBTree(t, m)
+			assertSliceEqual(t, m.Keys(), keys)
+
+			// The loaded tree must remain a valid B-tree under further changes.
+			m.Set(1, -1)
+			m.Remove(0)
+			checkBTree(t, m)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for unsorted keys, but did not panic")
+		}
+	}()
+	NewBTreeMap[int, int](0).BulkLoad([]int{1, 1}, []int{1, 2})
+}
+
+func TestBTreeMap_Clone(t *testing.T) {
+	m := NewBTreeMap[int, int](2)
+	keys := make([]int, 100)
+	for i := range keys {
+		keys[i] = i
+	}
+	m.BulkLoad(keys, keys)
+
+	clone := m.Clone()
+	for i := 0; i < 100; i += 2 {
+		m.Remove(i)
+		clone.Set(i, -i)
+	}
+	m.Set(1000, 1000)
+
+	if m.Count() != 51 || clone.Count() != 100 {
+		t.Errorf("Count() = %d, %d; want 51, 100", m.Count(), clone.Count())
+	}
+	if v, _ := clone.Get(4); v != -4 {
+		t.Errorf("clone.Get(4) = %d; want -4", v)
+	}
+	if v, _ := m.Get(5); v != 5 {
+		t.Errorf("Get(5) = %d; want 5", v)
+	}
+	checkBTree(t, m)
+	checkBTree(t, clone)
+}
+
+func TestBTreeMap_ConcurrentClone(t *testing.T) {
+	m := NewBTreeMap[int, int](2)
+	for i := 0; i < 100; i++ {
+		m.Set(i, i)
+	}
+
+	// Release the readers together so that their clones overlap.
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			<-start
+			for i := 0; i < 200; i++ {
+				clone := m.Clone()
+				clone.Set(g, -1)
+				clone.Remove(99)
+				if clone.Count() != 99 || m.Count() != 100 {
+					t.Errorf("Count() = %d, %d; want 99, 100", clone.Count(), m.Count())
+					return
+				}
+				if v, _ := m.Get(g); v != g {
+					t.Errorf("Get(%d) = %d after changing a clone; want %d", g, v, g)
+					return
+				}
+			}
+		}(g)
+	}
+	close(start)
+	wg.Wait()
+
+	m.Set(0, 1000)
+	checkBTree(t, m)
+	if v, _ := m.Get(0); v != 1000 {
+		t.Errorf("Get(0) = %d; want 1000", v)
+	}
+}
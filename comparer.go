@@ -0,0 +1,303 @@
+package collections
+
+import (
+	"bytes"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// EqualityComparer decides whether two values of type T are equal.
+type EqualityComparer[T any] interface {
+	Equal(a, b T) bool
+}
+
+// Hasher is an EqualityComparer that can also hash values, which lets hash-based
+// collections store types that are not comparable or that need a custom notion of equality.
+// Values that are Equal must have the same Hash.
+type Hasher[T any] interface {
+	EqualityComparer[T]
+	Hash(value T) uint64
+}
+
+// EqualityFunc adapts an ordinary function to the EqualityComparer interface.
+type EqualityFunc[T any] func(a, b T) bool
+
+// Equal calls f(a, b).
+func (f EqualityFunc[T]) Equal(a, b T) bool {
+	return f(a, b)
+}
+
+// NewHasher builds a Hasher from an equality function and a hash function.
+//
+// Example:
+//  byID := NewHasher(
+//  	func(a, b User) bool { return a.ID == b.ID },
+//  	func(u User) uint64 { return uint64(u.ID) },
+//  )
+//  users := NewHashSetWithComparer(byID)
+func NewHasher[T any](equal func(a, b T) bool, hash func(value T) uint64) Hasher[T] {
+	return funcHasher[T]{equal: equal, hash: hash}
+}
+
+type funcHasher[T any] struct {
+	equal func(a, b T) bool
+	hash  func(value T) uint64
+}
+
+func (h funcHasher[T]) Equal(a, b T) bool {
+	return h.equal(a, b)
+}
+
+func (h funcHasher[T]) Hash(value T) uint64 {
+	return h.hash(value)
+}
+
+// DefaultComparer returns a Hasher that compares values with == and hashes their contents.
+// It is the comparer used by collections constructed without an explicit comparer.
+func DefaultComparer[T comparable]() Hasher[T] {
+	return defaultHasher[T]{seed: randomSeed()}
+}
+
+// DefaultComparerWithSeed is like DefaultComparer but hashes with a fixed seed, so hashes,
+// and the iteration order of open-addressing collections, are reproducible across runs.
+func DefaultComparerWithSeed[T comparable](seed uint64) Hasher[T] {
+	return defaultHasher[T]{seed: seed}
+}
+
+type defaultHasher[T comparable] struct {
+	seed uint64
+}
+
+func (h defaultHasher[T]) Equal(a, b T) bool {
+	return a == b
+}
+
+func (h defaultHasher[T]) Hash(value T) uint64 {
+	s := newHashState(h.seed)
+	switch v := any(value).(type) {
+	case string:
+		s.addString(v)
+	case int:
+		s.addUint64(uint64(v))
+	case int64:
+		s.addUint64(uint64(v))
+	case int32:
+		s.addUint64(uint64(v))
+	case uint:
+		s.addUint64(uint64(v))
+	case uint64:
+		s.addUint64(v)
+	case uint32:
+		s.addUint64(uint64(v))
+	default:
+		s.addValue(reflect.ValueOf(&value).Elem())
+	}
+	return s.sum()
+}
+
+// IgnoreCaseComparer returns a Hasher for strings that ignores case under Unicode simple
+// case folding, matching strings.EqualFold.
+func IgnoreCaseComparer() Hasher[string] {
+	return ignoreCaseHasher{seed: randomSeed()}
+}
+
+type ignoreCaseHasher struct {
+	seed uint64
+}
+
+func (h ignoreCaseHasher) Equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func (h ignoreCaseHasher) Hash(value string) uint64 {
+	s := newHashState(h.seed)
+	for _, r := range value {
+		s.addUint64(uint64(foldRune(r)))
+	}
+	return s.sum()
+}
+
+// foldRune maps r to the smallest rune of its simple case folding orbit,
+// so that runes equal under strings.EqualFold map to the same value.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < smallest {
+			smallest = f
+		}
+	}
+	return smallest
+}
+
+// BytesComparer returns a Hasher that compares byte slices by content.
+func BytesComparer() Hasher[[]byte] {
+	return bytesHasher{seed: randomSeed()}
+}
+
+type bytesHasher struct {
+	seed uint64
+}
+
+func (h bytesHasher) Equal(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
+
+func (h bytesHasher) Hash(value []byte) uint64 {
+	s := newHashState(h.seed)
+	s.addBytes(value)
+	return s.sum()
+}
+
+// ProjectionComparer returns a Hasher that compares values by a comparable key derived from them,
+// such as a struct field. It allows values with slice or map fields to be stored by identity.
+//
+// Example:
+//  byName := ProjectionComparer(func(p Person) string { return p.Name })
+//  people := NewHashSetWithComparer(byName)
+func ProjectionComparer[T any, K comparable](key func(value T) K) Hasher[T] {
+	return projectionHasher[T, K]{key: key, inner: defaultHasher[K]{seed: randomSeed()}}
+}
+
+type projectionHasher[T any, K comparable] struct {
+	key   func(value T) K
+	inner defaultHasher[K]
+}
+
+func (h projectionHasher[T, K]) Equal(a, b T) bool {
+	return h.key(a) == h.key(b)
+}
+
+func (h projectionHasher[T, K]) Hash(value T) uint64 {
+	return h.inner.Hash(h.key(value))
+}
+
+// equalValues compares a and b with comparer, falling back to interface equality when comparer is nil.
+// The fallback panics for values whose dynamic type is not comparable, just as == would.
+func equalValues[T any](comparer EqualityComparer[T], a, b T) bool {
+	if comparer != nil {
+		return comparer.Equal(a, b)
+	}
+	return any(a) == any(b)
+}
+
+// randomSeed returns a random 64-bit value suitable for seeding hashes.
+func randomSeed() uint64 {
+	var h maphash.Hash
+	return h.Sum64()
+}
+
+// hashState accumulates a 64-bit hash from a seed and a sequence of words.
+type hashState struct {
+	h uint64
+}
+
+func newHashState(seed uint64) hashState {
+	return hashState{h: seed ^ 0x9e3779b97f4a7c15}
+}
+
+// mix64 is the splitmix64 finalizer, a fast bijective bit mixer.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func (s *hashState) addUint64(v uint64) {
+	s.h = mix64(s.h ^ v)
+}
+
+func (s *hashState) addString(str string) {
+	i := 0
+	for ; i+8 <= len(str); i += 8 {
+		s.addUint64(uint64(str[i]) | uint64(str[i+1])<<8 | uint64(str[i+2])<<16 | uint64(str[i+3])<<24 |
+			uint64(str[i+4])<<32 | uint64(str[i+5])<<40 | uint64(str[i+6])<<48 | uint64(str[i+7])<<56)
+	}
+	var tail uint64
+	for shift := 0; i < len(str); i, shift = i+1, shift+8 {
+		tail |= uint64(str[i]) << shift
+	}
+	s.addUint64(tail)
+	s.addUint64(uint64(len(str)))
+}
+
+func (s *hashState) addBytes(b []byte) {
+	i := 0
+	for ; i+8 <= len(b); i += 8 {
+		s.addUint64(uint64(b[i]) | uint64(b[i+1])<<8 | uint64(b[i+2])<<16 | uint64(b[i+3])<<24 |
+			uint64(b[i+4])<<32 | uint64(b[i+5])<<40 | uint64(b[i+6])<<48 | uint64(b[i+7])<<56)
+	}
+	var tail uint64
+	for shift := 0; i < len(b); i, shift = i+1, shift+8 {
+		tail |= uint64(b[i]) << shift
+	}
+	s.addUint64(tail)
+	s.addUint64(uint64(len(b)))
+}
+
+// addValue hashes an arbitrary comparable value so that values equal under == hash identically.
+func (s *hashState) addValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			s.addUint64(1)
+		} else {
+			s.addUint64(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s.addUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s.addUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		s.addFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		s.addFloat(real(c))
+		s.addFloat(imag(c))
+	case reflect.String:
+		s.addString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		s.addUint64(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			s.addValue(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			s.addValue(v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			s.addUint64(0)
+		} else {
+			s.addValue(v.Elem())
+		}
+	default:
+		panic("Cannot hash value of non-comparable type " + v.Type().String())
+	}
+}
+
+func (s *hashState) addFloat(f float64) {
+	if f == 0 {
+		// +0 and -0 are equal, so they must hash alike.
+		f = 0
+	}
+	s.addUint64(math.Float64bits(f))
+}
+
+func (s *hashState) sum() uint64 {
+	return mix64(s.h)
+}
@@ -0,0 +1,148 @@
+package collections
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDefaultComparer(t *testing.T) {
+	type point struct {
+		X, Y float64
+		Tag  any
+	}
+	c := DefaultComparer[point]()
+
+	a := point{X: 0, Y: 1, Tag: "t"}
+	b := point{X: math.Copysign(0, -1), Y: 1, Tag: "t"}
+	if !c.Equal(a, b) {
+		t.Fatal("Equal() = false; want true")
+	}
+	if c.Hash(a) != c.Hash(b) {
+		t.Error("Hash() differs for equal values")
+	}
+	if c.Hash(a) == c.Hash(point{X: 2, Y: 1, Tag: "t"}) {
+		t.Error("Hash() collides for distinct values")
+	}
+
+	s := DefaultComparer[string]()
+	if s.Hash("hello") != s.Hash("hel"+"lo") {
+		t.Error("Hash() differs for equal strings")
+	}
+}
+
+func TestIgnoreCaseComparer(t *testing.T) {
+	c := IgnoreCaseComparer()
+	pairs := [][2]string{
+		{"Hello", "hELLO"},
+		{"straße", "STRAßE"},
+		{"kelvin", "Kelvin"},
+		{"ſ", "S"},
+	}
+	for _, p := range pairs {
+		if !c.Equal(p[0], p[1]) {
+			t.Errorf("Equal(%q, %q) = false; want true", p[0], p[1])
+		}
+		if c.Hash(p[0]) != c.Hash(p[1]) {
+			t.Errorf("Hash(%q) != Hash(%q)", p[0], p[1])
+		}
+	}
+	if c.Equal("abc", "abd") {
+		t.Error("Equal(abc, abd) = true; want false")
+	}
+}
+
+func TestHashSetWithComparer(t *testing.T) {
+	set := NewHashSetWithComparer(BytesComparer())
+	if !set.Add([]byte("a")) {
+		t.Error("Add() = false; want true")
+	}
+	if set.Add([]byte("a")) {
+		t.Error("Add() = true; want false")
+	}
+	set.Add([]byte("b"))
+
+	if !set.Contains([]byte("b")) {
+		t.Error("Contains() = false; want true")
+	}
+	if !set.Remove([]byte("a")) {
+		t.Error("Remove() = false; want true")
+	}
+	if count := set.Count(); count != 1 {
+		t.Errorf("Count() = %d; want 1", count)
+	}
+	if items := set.Items(); len(items) != 1 || string(items[0]) != "b" {
+		t.Errorf("Items() = %q; want [b]", items)
+	}
+}
+
+func TestDictionaryWithComparer(t *testing.T) {
+	type user struct {
+		ID    int
+		Roles []string
+	}
+	dict := NewDictionaryWithComparer[user, string](ProjectionComparer(func(u user) int { return u.ID }))
+
+	dict.Set(user{ID: 1, Roles: []string{"admin"}}, "alice")
+	dict.Set(user{ID: 2}, "bob")
+	dict.Set(user{ID: 1}, "alice2")
+
+	if value, ok := dict.Get(user{ID: 1}); !ok || value != "alice2" {
+		t.Errorf("Get() = %v, %v; want alice2, true", value, ok)
+	}
+	if count := dict.Count(); count != 2 {
+		t.Errorf("Count() = %d; want 2", count)
+	}
+
+	headers := NewDictionaryWithComparer[string, string](IgnoreCaseComparer())
+	headers.Set("Content-Type", "text/plain")
+	if value, ok := headers.Get("content-type"); !ok || value != "text/plain" {
+		t.Errorf("Get() = %v, %v; want text/plain, true", value, ok)
+	}
+}
+
+func TestArrayListWithComparer(t *testing.T) {
+	list := NewArrayListWithComparer(IgnoreCaseComparer(), "Alpha", "Beta", "Gamma")
+
+	if i := list.IndexOf("beta"); i != 1 {
+		t.Errorf("IndexOf(beta) = %d; want 1", i)
+	}
+	if !list.Contains("GAMMA") {
+		t.Error("Contains(GAMMA) = false; want true")
+	}
+	if !list.Remove("alpha", nil) {
+		t.Error("Remove(alpha, nil) = false; want true")
+	}
+	if i := list.GetIndex("Beta", func(a, b string) bool { return a == b }); i != 0 {
+		t.Errorf("GetIndex(Beta, ==) = %d; want 0", i)
+	}
+}
+
+func TestLinkedListWithComparer(t *testing.T) {
+	ll := NewLinkedListWithComparer(BytesComparer())
+	for _, s := range []string{"a", "b", "a", "c", "b"} {
+		ll.Add([]byte(s))
+	}
+
+	if i := ll.IndexOf([]byte("c")); i != 3 {
+		t.Errorf("IndexOf(c) = %d; want 3", i)
+	}
+	if removed := ll.RemoveDuplicates(); removed != 2 {
+		t.Errorf("RemoveDuplicates() = %d; want 2", removed)
+	}
+
+	quadratic := NewLinkedListWithComparer[[]int](EqualityFunc[[]int](func(a, b []int) bool {
+		return len(a) == len(b)
+	}))
+	quadratic.Add([]int{1})
+	quadratic.Add([]int{2})
+	quadratic.Add([]int{1, 2})
+	if removed := quadratic.RemoveDuplicates(); removed != 1 {
+		t.Errorf("RemoveDuplicates() = %d; want 1", removed)
+	}
+
+	dll := NewDoublyLinkedListWithComparer(IgnoreCaseComparer())
+	dll.Add("Hello")
+	if !dll.Remove("HELLO") {
+		t.Error("Remove(HELLO) = false; want true")
+	}
+}
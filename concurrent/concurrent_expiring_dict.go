@@ -0,0 +1,200 @@
+package concurrent
+
+import (
+	"sync"
+	"time"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// ConcurrentExpiringDict is a thread-safe dictionary whose entries expire after a time-to-live.
+// Expired entries are removed lazily on read, by RemoveExpired, or by an optional background janitor.
+// Expiry callbacks run after the internal lock has been released, so they may call back into the dictionary.
+type ConcurrentExpiringDict[K comparable, V any] struct {
+	mu        sync.Mutex
+	dict      *collections.ExpiringDictionary[K, V]
+	onExpired func(key K, value V)
+	pending   []expiredEntry[K, V]
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+type expiredEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewConcurrentExpiringDict initializes a new empty ConcurrentExpiringDict whose entries
+// expire after defaultTTL unless a TTL is given explicitly with SetWithTTL.
+func NewConcurrentExpiringDict[K comparable, V any](defaultTTL time.Duration) *ConcurrentExpiringDict[K, V] {
+	d := &ConcurrentExpiringDict[K, V]{
+		dict: collections.NewExpiringDictionary[K, V](defaultTTL),
+	}
+	d.dict.OnExpired(func(key K, value V) {
+		d.pending = append(d.pending, expiredEntry[K, V]{key: key, value: value})
+	})
+	return d
+}
+
+// SetClock replaces the function used to read the current time.
+// It is intended for deterministic tests.
+func (d *ConcurrentExpiringDict[K, V]) SetClock(now func() time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict.SetClock(now)
+}
+
+// SetSlidingExpiration enables or disables sliding expiration.
+// When enabled, every successful Get pushes the entry's expiry back by its TTL.
+func (d *ConcurrentExpiringDict[K, V]) SetSlidingExpiration(enabled bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict.SetSlidingExpiration(enabled)
+}
+
+// OnExpired registers a callback that is invoked for every entry removed because it expired.
+func (d *ConcurrentExpiringDict[K, V]) OnExpired(callback func(key K, value V)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.onExpired = callback
+}
+
+// Set adds or updates a key-value pair that expires after the default TTL.
+func (d *ConcurrentExpiringDict[K, V]) Set(key K, value V) {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	d.dict.Set(key, value)
+}
+
+// SetWithTTL adds or updates a key-value pair that expires after ttl.
+func (d *ConcurrentExpiringDict[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	d.dict.SetWithTTL(key, value, ttl)
+}
+
+// Get retrieves the value for the given key if a live entry exists.
+func (d *ConcurrentExpiringDict[K, V]) Get(key K) (V, bool) {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.Get(key)
+}
+
+// ContainsKey checks if a live entry exists for key without refreshing a sliding expiration.
+func (d *ConcurrentExpiringDict[K, V]) ContainsKey(key K) bool {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.ContainsKey(key)
+}
+
+// TTL returns the time left before the entry for key expires.
+func (d *ConcurrentExpiringDict[K, V]) TTL(key K) (time.Duration, bool) {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.TTL(key)
+}
+
+// Delete removes the value for the given key.
+func (d *ConcurrentExpiringDict[K, V]) Delete(key K) {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	d.dict.Remove(key)
+}
+
+// RemoveExpired removes every expired entry and returns how many were removed.
+func (d *ConcurrentExpiringDict[K, V]) RemoveExpired() int {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.RemoveExpired()
+}
+
+// Keys returns a slice of all live keys in the ConcurrentExpiringDict.
+func (d *ConcurrentExpiringDict[K, V]) Keys() []K {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.Keys()
+}
+
+// Values returns a slice of all live values in the ConcurrentExpiringDict.
+func (d *ConcurrentExpiringDict[K, V]) Values() []V {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.Values()
+}
+
+// Count returns the number of live entries in the ConcurrentExpiringDict.
+func (d *ConcurrentExpiringDict[K, V]) Count() int {
+	d.mu.Lock()
+	defer d.unlockAndNotify()
+	return d.dict.Count()
+}
+
+// Clear removes all entries without invoking the expiry callback.
+func (d *ConcurrentExpiringDict[K, V]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dict.Clear()
+}
+
+// StartJanitor starts a background goroutine that removes expired entries every interval.
+// Calling StartJanitor while a janitor is already running has no effect.
+func (d *ConcurrentExpiringDict[K, V]) StartJanitor(interval time.Duration) {
+	if interval <= 0 {
+		panic("Janitor interval must be positive.")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stop != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	d.stop, d.done = stop, done
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				d.RemoveExpired()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// StopJanitor stops the background janitor and waits for it to exit.
+// It is safe to call StopJanitor when no janitor is running.
+func (d *ConcurrentExpiringDict[K, V]) StopJanitor() {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop, d.done = nil, nil
+	d.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// unlockAndNotify releases the lock and then delivers any expiry notifications
+// collected while it was held.
+func (d *ConcurrentExpiringDict[K, V]) unlockAndNotify() {
+	expired := d.pending
+	d.pending = nil
+	callback := d.onExpired
+	d.mu.Unlock()
+
+	if callback == nil {
+		return
+	}
+	for _, e := range expired {
+		callback(e.key, e.value)
+	}
+}
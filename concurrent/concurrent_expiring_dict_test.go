@@ -0,0 +1,111 @@
+package concurrent
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestConcurrentExpiringDict(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	dict := NewConcurrentExpiringDict[string, int](time.Minute)
+	dict.SetClock(clock.Now)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				dict.Set(strconv.Itoa(i), i)
+			} else {
+				dict.SetWithTTL(strconv.Itoa(i), i, time.Hour)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if count := dict.Count(); count != 100 {
+		t.Errorf("Count() = %d; want 100", count)
+	}
+
+	clock.Advance(time.Minute)
+	for i := 0; i < 100; i++ {
+		_, ok := dict.Get(strconv.Itoa(i))
+		if ok != (i%2 == 1) {
+			t.Errorf("Get(%d) found = %v; want %v", i, ok, i%2 == 1)
+		}
+	}
+}
+
+func TestConcurrentExpiringDict_CallbackMayReenter(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	dict := NewConcurrentExpiringDict[string, int](time.Minute)
+	dict.SetClock(clock.Now)
+
+	// The callback re-inserts the entry; this would deadlock if it ran under the lock.
+	dict.OnExpired(func(key string, value int) {
+		dict.SetWithTTL(key, value+1, 0)
+	})
+
+	dict.Set("a", 1)
+	clock.Advance(time.Minute)
+	if removed := dict.RemoveExpired(); removed != 1 {
+		t.Errorf("RemoveExpired() = %d; want 1", removed)
+	}
+	if value, ok := dict.Get("a"); !ok || value != 2 {
+		t.Errorf("Get() = %v, %v; want 2, true", value, ok)
+	}
+}
+
+func TestConcurrentExpiringDict_Janitor(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	set := NewConcurrentExpiringSet[int](time.Minute)
+	set.SetClock(clock.Now)
+
+	expired := make(chan int, 10)
+	set.OnExpired(func(item int) {
+		expired <- item
+	})
+
+	set.Add(1)
+	set.AddWithTTL(2, time.Hour)
+
+	set.StartJanitor(time.Millisecond)
+	set.StartJanitor(time.Millisecond)
+	clock.Advance(time.Minute)
+
+	select {
+	case item := <-expired:
+		if item != 1 {
+			t.Errorf("expired item = %d; want 1", item)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("janitor did not remove the expired item")
+	}
+
+	set.StopJanitor()
+	set.StopJanitor()
+
+	if !set.Contains(2) {
+		t.Errorf("Contains(2) = false; want true")
+	}
+}
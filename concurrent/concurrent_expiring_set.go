@@ -0,0 +1,107 @@
+package concurrent
+
+import "time"
+
+// ConcurrentExpiringSet is a thread-safe set of unique items that expire after a time-to-live.
+// It shares its expiry semantics with ConcurrentExpiringDict.
+type ConcurrentExpiringSet[T comparable] struct {
+	items *ConcurrentExpiringDict[T, struct{}]
+}
+
+// NewConcurrentExpiringSet initializes a new empty ConcurrentExpiringSet whose items expire after defaultTTL.
+func NewConcurrentExpiringSet[T comparable](defaultTTL time.Duration) *ConcurrentExpiringSet[T] {
+	return &ConcurrentExpiringSet[T]{
+		items: NewConcurrentExpiringDict[T, struct{}](defaultTTL),
+	}
+}
+
+// SetClock replaces the function used to read the current time.
+func (s *ConcurrentExpiringSet[T]) SetClock(now func() time.Time) {
+	s.items.SetClock(now)
+}
+
+// SetSlidingExpiration enables or disables sliding expiration.
+// When enabled, every successful Contains pushes the item's expiry back by its TTL.
+func (s *ConcurrentExpiringSet[T]) SetSlidingExpiration(enabled bool) {
+	s.items.SetSlidingExpiration(enabled)
+}
+
+// OnExpired registers a callback that is invoked for every item removed because it expired.
+func (s *ConcurrentExpiringSet[T]) OnExpired(callback func(item T)) {
+	if callback == nil {
+		s.items.OnExpired(nil)
+		return
+	}
+	s.items.OnExpired(func(item T, _ struct{}) {
+		callback(item)
+	})
+}
+
+// Add adds an item that expires after the default TTL.
+// Returns true if the item was added, false if it was already present, in which case its expiry is reset.
+func (s *ConcurrentExpiringSet[T]) Add(item T) bool {
+	s.items.mu.Lock()
+	defer s.items.unlockAndNotify()
+	exists := s.items.dict.ContainsKey(item)
+	s.items.dict.Set(item, struct{}{})
+	return !exists
+}
+
+// AddWithTTL adds an item that expires after ttl.
+// Returns true if the item was added, false if it was already present, in which case its expiry is reset.
+func (s *ConcurrentExpiringSet[T]) AddWithTTL(item T, ttl time.Duration) bool {
+	s.items.mu.Lock()
+	defer s.items.unlockAndNotify()
+	exists := s.items.dict.ContainsKey(item)
+	s.items.dict.SetWithTTL(item, struct{}{}, ttl)
+	return !exists
+}
+
+// Remove removes an item from the ConcurrentExpiringSet.
+// Returns true if the item was removed, false if it was not present or had already expired.
+func (s *ConcurrentExpiringSet[T]) Remove(item T) bool {
+	s.items.mu.Lock()
+	defer s.items.unlockAndNotify()
+	return s.items.dict.Remove(item)
+}
+
+// Contains checks if a live item is present in the ConcurrentExpiringSet.
+func (s *ConcurrentExpiringSet[T]) Contains(item T) bool {
+	_, ok := s.items.Get(item)
+	return ok
+}
+
+// TTL returns the time left before item expires.
+func (s *ConcurrentExpiringSet[T]) TTL(item T) (time.Duration, bool) {
+	return s.items.TTL(item)
+}
+
+// RemoveExpired removes every expired item and returns how many were removed.
+func (s *ConcurrentExpiringSet[T]) RemoveExpired() int {
+	return s.items.RemoveExpired()
+}
+
+// Count returns the number of live items in the ConcurrentExpiringSet.
+func (s *ConcurrentExpiringSet[T]) Count() int {
+	return s.items.Count()
+}
+
+// Clear removes all items from the ConcurrentExpiringSet.
+func (s *ConcurrentExpiringSet[T]) Clear() {
+	s.items.Clear()
+}
+
+// Items returns a slice of all live items in the ConcurrentExpiringSet.
+func (s *ConcurrentExpiringSet[T]) Items() []T {
+	return s.items.Keys()
+}
+
+// StartJanitor starts a background goroutine that removes expired items every interval.
+func (s *ConcurrentExpiringSet[T]) StartJanitor(interval time.Duration) {
+	s.items.StartJanitor(interval)
+}
+
+// StopJanitor stops the background janitor and waits for it to exit.
+func (s *ConcurrentExpiringSet[T]) StopJanitor() {
+	s.items.StopJanitor()
+}
@@ -0,0 +1,112 @@
+package concurrent
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentExpiringSet(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	set := NewConcurrentExpiringSet[int](time.Minute)
+	set.SetClock(clock.Now)
+
+	// Every item is added by two goroutines; exactly one of them must see it as new.
+	var added atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			item := i / 2
+			var ok bool
+			if item%2 == 0 {
+				ok = set.Add(item)
+			} else {
+				ok = set.AddWithTTL(item, time.Hour)
+			}
+			if ok {
+				added.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if n := added.Load(); n != 100 {
+		t.Errorf("Add() returned true %d times; want 100", n)
+	}
+	if count := set.Count(); count != 100 {
+		t.Errorf("Count() = %d; want 100", count)
+	}
+
+	clock.Advance(time.Minute)
+	var removed atomic.Int64
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if set.Remove(i) {
+				removed.Add(1)
+				if i%2 == 0 {
+					t.Errorf("Remove(%d) = true after expiry; want false", i)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if n := removed.Load(); n != 50 {
+		t.Errorf("Remove() returned true %d times; want 50", n)
+	}
+	if count := set.Count(); count != 0 {
+		t.Errorf("Count() = %d; want 0", count)
+	}
+}
+
+func TestConcurrentExpiringSet_AddRemove(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	set := NewConcurrentExpiringSet[string](time.Minute)
+	set.SetClock(clock.Now)
+
+	var expired []string
+	set.OnExpired(func(item string) {
+		expired = append(expired, item)
+	})
+
+	if !set.Add("a") {
+		t.Errorf("Add() = false; want true")
+	}
+	if set.Add("a") {
+		t.Errorf("Add() = true; want false")
+	}
+	if !set.AddWithTTL("b", time.Hour) {
+		t.Errorf("AddWithTTL() = false; want true")
+	}
+	if set.AddWithTTL("b", time.Hour) {
+		t.Errorf("AddWithTTL() = true; want false")
+	}
+	if set.Remove("c") {
+		t.Errorf("Remove() of a missing item = true; want false")
+	}
+
+	clock.Advance(time.Minute)
+	if set.Remove("a") {
+		t.Errorf("Remove() of an expired item = true; want false")
+	}
+	if !set.Remove("b") {
+		t.Errorf("Remove() = false; want true")
+	}
+	if !set.Add("a") {
+		t.Errorf("Add() after expiry = false; want true")
+	}
+
+	sort.Strings(expired)
+	if len(expired) != 1 || expired[0] != "a" {
+		t.Errorf("expired = %v; want [a]", expired)
+	}
+	if items := set.Items(); len(items) != 1 || items[0] != "a" {
+		t.Errorf("Items() = %v; want [a]", items)
+	}
+}
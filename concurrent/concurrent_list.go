@@ -1,40 +1,41 @@
 package concurrent
 
 import (
-	"sort"
 	"sync"
+
+	"github.com/VikashChauhan51/collections"
 )
 
 // ConcurrentList is a thread-safe list.
+// It guards a collections.List with a mutex and offers the same methods.
+// Predicates passed to its methods run while the lock is held and must not call back into the list.
 type ConcurrentList[T comparable] struct {
-	mu         sync.Mutex
-	collection []T
+	mu   sync.Mutex
+	list *collections.List[T]
 }
 
 // NewConcurrentList initializes a new empty ConcurrentList.
 func NewConcurrentList[T comparable]() *ConcurrentList[T] {
-	return &ConcurrentList[T]{collection: []T{}}
+	return &ConcurrentList[T]{list: collections.NewList[T]()}
 }
 
 // NewConcurrentListT initializes a new ConcurrentList with the given items.
 func NewConcurrentListT[T comparable](items ...T) *ConcurrentList[T] {
-	l := &ConcurrentList[T]{collection: make([]T, len(items))}
-	copy(l.collection, items)
-	return l
+	return &ConcurrentList[T]{list: collections.NewListT(items...)}
 }
 
 // Count returns the number of items in the ConcurrentList.
 func (l *ConcurrentList[T]) Count() int {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	return len(l.collection)
+	return l.list.Count()
 }
 
 // Clear removes all items from the ConcurrentList.
 func (l *ConcurrentList[T]) Clear() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.collection = []T{}
+	l.list.Clear()
 }
 
 // Items returns a slice of all items in the ConcurrentList.
@@ -42,8 +43,9 @@ func (l *ConcurrentList[T]) Items() []T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 	// Return a copy of the slice to avoid data races
-	result := make([]T, len(l.collection))
-	copy(result, l.collection)
+	items := l.list.Items()
+	result := make([]T, len(items))
+	copy(result, items)
 	return result
 }
 
@@ -51,81 +53,87 @@ func (l *ConcurrentList[T]) Items() []T {
 func (l *ConcurrentList[T]) Add(item T) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.collection = append(l.collection, item)
+	l.list.Add(item)
 }
 
 // AddRange appends multiple items to the ConcurrentList.
 func (l *ConcurrentList[T]) AddRange(items []T) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.collection = append(l.collection, items...)
+	l.list.AddRange(items)
 }
 
 // Get retrieves the item at the specified index.
 func (l *ConcurrentList[T]) Get(index int) T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if index < 0 || index >= len(l.collection) {
-		panic("Index out of range.")
-	}
-	return l.collection[index]
+	return l.list.Get(index)
 }
 
 // Set updates the item at the specified index.
 func (l *ConcurrentList[T]) Set(index int, item T) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if index < 0 || index >= len(l.collection) {
-		panic("Index out of range.")
-	}
-	l.collection[index] = item
+	l.list.Set(index, item)
+}
+
+// GetIndex retrieves the index of the specified item in the ConcurrentList.
+// Returns -1 if the item is not found.
+func (l *ConcurrentList[T]) GetIndex(item T) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.GetIndex(item)
+}
+
+// IndexOf retrieves the index of the specified item in the ConcurrentList.
+// Returns -1 if the item is not found.
+func (l *ConcurrentList[T]) IndexOf(item T) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.IndexOf(item)
+}
+
+// Contains checks if the ConcurrentList contains the specified item.
+func (l *ConcurrentList[T]) Contains(item T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Contains(item)
 }
 
 // Remove removes the first occurrence of the specified item from the ConcurrentList.
 func (l *ConcurrentList[T]) Remove(item T) bool {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	for i, v := range l.collection {
-		if v == item {
-			l.collection = append(l.collection[:i], l.collection[i+1:]...)
-			return true
-		}
-	}
-	return false
+	return l.list.Remove(item)
 }
 
 // RemoveAt removes the item at the specified index.
 func (l *ConcurrentList[T]) RemoveAt(index int) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	if index < 0 || index >= len(l.collection) {
-		panic("Index out of range.")
-	}
-	l.collection = append(l.collection[:index], l.collection[index+1:]...)
+	l.list.RemoveAt(index)
 }
 
 // OrderBy sorts the list using the provided less function
 func (l *ConcurrentList[T]) OrderBy(less func(i, j T) bool) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	sort.Slice(l.collection, func(i, j int) bool {
-		return less(l.collection[i], l.collection[j])
-	})
+	l.list.OrderBy(less)
 }
 
 // Filter returns a new slice containing all items that match the predicate
 func (l *ConcurrentList[T]) Filter(predicate func(T) bool) []T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
+	return l.list.Filter(predicate)
+}
 
-	results := make([]T, 0)
-	for _, val := range l.collection {
-		if predicate(val) {
-			results = append(results, val)
-		}
-	}
-
-	return results
+// First returns the first item that matches the predicate.
+// If no item matches, it will panic.
+func (l *ConcurrentList[T]) First(predicate func(T) bool) T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.First(predicate)
 }
 
 // FirstOrDefault returns the first item that matches the predicate, or the zero value of T if no match is found.
@@ -133,15 +141,7 @@ func (l *ConcurrentList[T]) Filter(predicate func(T) bool) []T {
 func (l *ConcurrentList[T]) FirstOrDefault(predicate func(T) bool) T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-
-	for _, val := range l.collection {
-		if predicate(val) {
-			return val
-		}
-	}
-
-	var zeroValue T
-	return zeroValue
+	return l.list.FirstOrDefault(predicate)
 }
 
 // LastOrDefault returns the last item that matches the predicate, or the zero value of T if no match is found.
@@ -149,15 +149,15 @@ func (l *ConcurrentList[T]) FirstOrDefault(predicate func(T) bool) T {
 func (l *ConcurrentList[T]) LastOrDefault(predicate func(T) bool) T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
+	return l.list.LastOrDefault(predicate)
+}
 
-	var zeroValue T
-	for _, val := range l.collection {
-		if predicate(val) {
-			zeroValue = val
-		}
-	}
-
-	return zeroValue
+// Single returns the single item that matches the predicate.
+// If no item or more than one item matches, it panics.
+func (l *ConcurrentList[T]) Single(predicate func(T) bool) T {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.Single(predicate)
 }
 
 // SingleOrDefault returns the single item that matches the predicate, or the zero value of T if no match is found.
@@ -165,21 +165,7 @@ func (l *ConcurrentList[T]) LastOrDefault(predicate func(T) bool) T {
 func (l *ConcurrentList[T]) SingleOrDefault(predicate func(T) bool) T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-
-	var result T
-	count := 0
-	for _, val := range l.collection {
-		if predicate(val) {
-			if count == 0 {
-				result = val
-				count++
-			} else {
-				panic("Sequence contains more than one matching element.")
-			}
-		}
-	}
-
-	return result
+	return l.list.SingleOrDefault(predicate)
 }
 
 // ConcurrentListIterator is an iterator for ConcurrentList.
@@ -199,13 +185,11 @@ func (it *ConcurrentListIterator[T]) HasNext() bool {
 	it.mu.Lock()
 	defer it.mu.Unlock()
 
-	it.list.mu.Lock()
-	defer it.list.mu.Unlock()
-
-	return it.index+1 < len(it.list.collection)
+	return it.index+1 < it.list.Count()
 }
 
 // Next returns the next item in the iteration.
+// It returns the zero value of T once the iteration is exhausted.
 func (it *ConcurrentListIterator[T]) Next() T {
 	it.mu.Lock()
 	defer it.mu.Unlock()
@@ -213,11 +197,11 @@ func (it *ConcurrentListIterator[T]) Next() T {
 	it.list.mu.Lock()
 	defer it.list.mu.Unlock()
 
-	if !it.HasNext() {
+	if it.index+1 >= it.list.list.Count() {
 		var zeroValue T
-		return zeroValue // or you might choose to panic or return an error
+		return zeroValue
 	}
 
 	it.index++
-	return it.list.collection[it.index]
+	return it.list.list.Get(it.index)
 }
@@ -56,3 +56,41 @@ func TestConcurrentListModification(t *testing.T) {
         }
     }
 }
+
+func TestConcurrentListQueries(t *testing.T) {
+	list := NewConcurrentListT(5, 3, 1, 4, 2)
+	list.OrderBy(func(i, j int) bool { return i < j })
+
+	even := func(item int) bool { return item%2 == 0 }
+	if v := list.First(even); v != 2 {
+		t.Errorf("First() = %d; want 2", v)
+	}
+	if v := list.Single(func(item int) bool { return item == 3 }); v != 3 {
+		t.Errorf("Single() = %d; want 3", v)
+	}
+	if i := list.IndexOf(4); i != 3 {
+		t.Errorf("IndexOf(4) = %d; want 3", i)
+	}
+	if !list.Contains(5) {
+		t.Error("Contains(5) = false; want true")
+	}
+
+	var result []int
+	iterator := list.NewIterator()
+	for iterator.HasNext() {
+		result = append(result, iterator.Next())
+	}
+	if len(result) != 5 || result[0] != 1 || result[4] != 5 {
+		t.Errorf("Iterated %v; want [1 2 3 4 5]", result)
+	}
+	if v := iterator.Next(); v != 0 {
+		t.Errorf("Next() after exhaustion = %d; want 0", v)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for more than one matching element, but did not panic")
+		}
+	}()
+	list.Single(even)
+}
@@ -2,12 +2,14 @@ package concurrent
 
 import (
 	"sync"
+
+	"github.com/VikashChauhan51/collections"
 )
 
-// ConcurrentQueue represents a generic, thread-safe queue data structure.
+// ConcurrentQueue represents a generic, thread-safe FIFO queue backed by a ring buffer.
 type ConcurrentQueue[T any] struct {
 	mu       sync.Mutex
-	elements []T
+	elements collections.Deque[T]
 }
 
 // NewConcurrentQueue creates a new instance of a ConcurrentQueue.
@@ -19,7 +21,16 @@ func NewConcurrentQueue[T any]() *ConcurrentQueue[T] {
 func (q *ConcurrentQueue[T]) Enqueue(element T) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
-	q.elements = append(q.elements, element)
+	q.elements.PushBack(element)
+}
+
+// EnqueueRange adds multiple elements to the end of the queue as a single atomic operation.
+func (q *ConcurrentQueue[T]) EnqueueRange(elements []T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, element := range elements {
+		q.elements.PushBack(element)
+	}
 }
 
 // Dequeue removes and returns the element from the front of the queue.
@@ -28,13 +39,29 @@ func (q *ConcurrentQueue[T]) Dequeue() T {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	if len(q.elements) == 0 {
+	if q.elements.IsEmpty() {
 		panic("Dequeue from an empty queue")
 	}
 
-	element := q.elements[0]
-	q.elements = q.elements[1:]
-	return element
+	return q.elements.PopFront()
+}
+
+// DequeueRange removes and returns up to count elements from the front of the queue
+// as a single atomic operation.
+func (q *ConcurrentQueue[T]) DequeueRange(count int) []T {
+	if count < 0 {
+		panic("Count cannot be negative.")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	count = min(count, q.elements.Size())
+	result := make([]T, count)
+	for i := range result {
+		result[i] = q.elements.PopFront()
+	}
+	return result
 }
 
 // Peek returns the element at the front of the queue without removing it.
@@ -43,11 +70,38 @@ func (q *ConcurrentQueue[T]) Peek() T {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	if len(q.elements) == 0 {
+	if q.elements.IsEmpty() {
 		panic("Peek from an empty queue")
 	}
 
-	return q.elements[0]
+	return q.elements.PeekFront()
+}
+
+// Contains checks if the queue contains the specified element, using equal to compare elements.
+func (q *ConcurrentQueue[T]) Contains(element T, equal func(T, T) bool) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i := 0; i < q.elements.Size(); i++ {
+		if equal(q.elements.Get(i), element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all elements from the queue.
+func (q *ConcurrentQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.elements.Clear()
+}
+
+// ToSlice returns a snapshot of the elements of the queue from front to back.
+func (q *ConcurrentQueue[T]) ToSlice() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.elements.ToSlice()
 }
 
 // IsEmpty returns true if the queue is empty, false otherwise.
@@ -55,7 +109,7 @@ func (q *ConcurrentQueue[T]) IsEmpty() bool {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	return len(q.elements) == 0
+	return q.elements.IsEmpty()
 }
 
 // Size returns the number of elements in the queue.
@@ -63,5 +117,5 @@ func (q *ConcurrentQueue[T]) Size() int {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	return len(q.elements)
+	return q.elements.Size()
 }
@@ -0,0 +1,77 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentQueue(t *testing.T) {
+	queue := NewConcurrentQueue[int]()
+	var wg sync.WaitGroup
+
+	// Test concurrent producers
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				queue.Enqueue(i*100 + j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if size := queue.Size(); size != 1000 {
+		t.Errorf("Size() = %d; want 1000", size)
+	}
+
+	// Test concurrent consumers
+	seen := make([]bool, 1000)
+	var mu sync.Mutex
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, v := range queue.DequeueRange(100) {
+				mu.Lock()
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for v, ok := range seen {
+		if !ok {
+			t.Errorf("Element %d was never dequeued", v)
+		}
+	}
+	if !queue.IsEmpty() {
+		t.Error("IsEmpty() = false; want true")
+	}
+}
+
+func TestConcurrentQueueOperations(t *testing.T) {
+	queue := NewConcurrentQueue[string]()
+	queue.EnqueueRange([]string{"a", "b", "c"})
+
+	if v := queue.Peek(); v != "a" {
+		t.Errorf("Peek() = %s; want a", v)
+	}
+	if !queue.Contains("b", func(x, y string) bool { return x == y }) {
+		t.Error("Contains(b) = false; want true")
+	}
+	if v := queue.Dequeue(); v != "a" {
+		t.Errorf("Dequeue() = %s; want a", v)
+	}
+
+	items := queue.ToSlice()
+	if len(items) != 2 || items[0] != "b" || items[1] != "c" {
+		t.Errorf("ToSlice() = %v; want [b c]", items)
+	}
+
+	queue.Clear()
+	if size := queue.Size(); size != 0 {
+		t.Errorf("Size() after Clear() = %d; want 0", size)
+	}
+}
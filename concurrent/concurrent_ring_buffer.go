@@ -0,0 +1,121 @@
+package concurrent
+
+import (
+	"sync"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// ConcurrentRingBuffer represents a generic, thread-safe fixed-capacity circular buffer.
+// Readers share a read lock, so taking a snapshot only holds writers off for the duration of one copy.
+type ConcurrentRingBuffer[T any] struct {
+	mu  sync.RWMutex
+	buf *collections.RingBuffer[T]
+}
+
+// NewConcurrentRingBuffer creates a new ConcurrentRingBuffer holding at most capacity elements.
+func NewConcurrentRingBuffer[T any](capacity int, policy collections.OverflowPolicy) *ConcurrentRingBuffer[T] {
+	return &ConcurrentRingBuffer[T]{
+		buf: collections.NewRingBuffer[T](capacity, policy),
+	}
+}
+
+// Push adds an element as the newest element of the buffer, applying the overflow policy when it is full.
+func (rb *ConcurrentRingBuffer[T]) Push(element T) error {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.buf.Push(element)
+}
+
+// Pop removes and returns the oldest element of the buffer.
+// It panics if the buffer is empty.
+func (rb *ConcurrentRingBuffer[T]) Pop() T {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	return rb.buf.Pop()
+}
+
+// TryPop removes and returns the oldest element of the buffer.
+// The boolean is false if the buffer was empty.
+func (rb *ConcurrentRingBuffer[T]) TryPop() (T, bool) {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	if rb.buf.IsEmpty() {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return rb.buf.Pop(), true
+}
+
+// Peek returns the oldest element of the buffer without removing it.
+// It panics if the buffer is empty.
+func (rb *ConcurrentRingBuffer[T]) Peek() T {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.Peek()
+}
+
+// PeekNewest returns the newest element of the buffer without removing it.
+// It panics if the buffer is empty.
+func (rb *ConcurrentRingBuffer[T]) PeekNewest() T {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.PeekNewest()
+}
+
+// Get retrieves the element at the specified index, where 0 is the oldest element.
+func (rb *ConcurrentRingBuffer[T]) Get(index int) T {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.Get(index)
+}
+
+// GetFromNewest retrieves the element at the specified index, where 0 is the newest element.
+func (rb *ConcurrentRingBuffer[T]) GetFromNewest(index int) T {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.GetFromNewest(index)
+}
+
+// Snapshot returns a consistent copy of the elements from oldest to newest.
+func (rb *ConcurrentRingBuffer[T]) Snapshot() []T {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.ToSlice()
+}
+
+// Size returns the number of elements in the buffer.
+func (rb *ConcurrentRingBuffer[T]) Size() int {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.Size()
+}
+
+// Capacity returns the maximum number of elements the buffer can hold.
+func (rb *ConcurrentRingBuffer[T]) Capacity() int {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.Capacity()
+}
+
+// IsEmpty returns true if the buffer is empty, false otherwise.
+func (rb *ConcurrentRingBuffer[T]) IsEmpty() bool {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.IsEmpty()
+}
+
+// IsFull returns true if the buffer holds Capacity elements, false otherwise.
+func (rb *ConcurrentRingBuffer[T]) IsFull() bool {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+	return rb.buf.IsFull()
+}
+
+// Clear removes all elements from the buffer.
+func (rb *ConcurrentRingBuffer[T]) Clear() {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+	rb.buf.Clear()
+}
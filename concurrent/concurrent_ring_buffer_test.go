@@ -0,0 +1,48 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+func TestConcurrentRingBuffer(t *testing.T) {
+	rb := NewConcurrentRingBuffer[int](64, collections.OverflowOverwrite)
+	var wg sync.WaitGroup
+
+	// Writers push increasing sequences while readers take snapshots.
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				rb.Push(i)
+			}
+		}()
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if snapshot := rb.Snapshot(); len(snapshot) > rb.Capacity() {
+					t.Errorf("Snapshot() returned %d elements; capacity is %d", len(snapshot), rb.Capacity())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if !rb.IsFull() {
+		t.Errorf("IsFull() = false; want true with size %d", rb.Size())
+	}
+	if v := rb.PeekNewest(); v != 999 {
+		t.Errorf("PeekNewest() = %d; want 999", v)
+	}
+
+	rb.Clear()
+	if _, ok := rb.TryPop(); ok {
+		t.Error("TryPop() on empty buffer returned true; want false")
+	}
+}
@@ -0,0 +1,402 @@
+package concurrent
+
+import (
+	"cmp"
+	"math/bits"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// skipListMaxLevel bounds the height of a skip list tower, which is ample for 2^32 entries.
+const skipListMaxLevel = 32
+
+// ConcurrentSkipListMap is a thread-safe sorted map implemented as a lazy skip list.
+// Lookups and iteration take no locks; inserts and deletes lock only the nodes next to the change,
+// so operations on different parts of the map proceed in parallel.
+// Iteration is weakly consistent: it never fails or visits a key twice, and it reflects some,
+// but not necessarily all, of the changes made while it runs.
+type ConcurrentSkipListMap[K any, V any] struct {
+	head    *skipListNode[K, V]
+	compare func(a, b K) int
+	size    atomic.Int64
+}
+
+type skipListNode[K any, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[skipListNode[K, V]]
+	mu    sync.Mutex
+	// marked is set, under mu, once the node is logically deleted.
+	marked atomic.Bool
+	// fullyLinked is set once the node is linked at every level of its tower.
+	fullyLinked atomic.Bool
+}
+
+// NewConcurrentSkipListMap initializes a new empty ConcurrentSkipListMap ordered by the natural order of K.
+func NewConcurrentSkipListMap[K cmp.Ordered, V any]() *ConcurrentSkipListMap[K, V] {
+	return NewConcurrentSkipListMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewConcurrentSkipListMapFunc initializes a new empty ConcurrentSkipListMap ordered by compare,
+// which returns a negative number, zero or a positive number when a sorts before, with or after b.
+func NewConcurrentSkipListMapFunc[K any, V any](compare func(a, b K) int) *ConcurrentSkipListMap[K, V] {
+	if compare == nil {
+		panic("Compare function cannot be nil.")
+	}
+	head := &skipListNode[K, V]{next: make([]atomic.Pointer[skipListNode[K, V]], skipListMaxLevel)}
+	head.fullyLinked.Store(true)
+	return &ConcurrentSkipListMap[K, V]{head: head, compare: compare}
+}
+
+// live reports whether n is fully inserted and not deleted.
+func (n *skipListNode[K, V]) live() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+func randomSkipListLevel() int {
+	// Each extra level is taken with probability 1/2.
+	return 1 + bits.TrailingZeros64(rand.Uint64()|1<<(skipListMaxLevel-1))
+}
+
+// find fills preds and succs with the nodes before and at-or-after key on every level,
+// and returns the highest level on which a node with key was found, or -1.
+func (m *ConcurrentSkipListMap[K, V]) find(key K, preds, succs *[skipListMaxLevel]*skipListNode[K, V]) int {
+	found := -1
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && m.compare(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found == -1 && curr != nil && m.compare(curr.key, key) == 0 {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// lockPreds locks the distinct predecessors on levels below topLevel and checks that each is live
+// and still precedes succs on its level, and that no successor other than victim is being deleted.
+// It returns an unlock function for the nodes it locked.
+func lockPreds[K any, V any](topLevel int, preds, succs *[skipListMaxLevel]*skipListNode[K, V], victim *skipListNode[K, V]) (bool, func()) {
+	var locked [skipListMaxLevel]*skipListNode[K, V]
+	count := 0
+	unlock := func() {
+		for i := 0; i < count; i++ {
+			locked[i].mu.Unlock()
+		}
+	}
+
+	for level := 0; level < topLevel; level++ {
+		pred, succ := preds[level], succs[level]
+		if count == 0 || locked[count-1] != pred {
+			pred.mu.Lock()
+			locked[count] = pred
+			count++
+		}
+		if pred.marked.Load() || pred.next[level].Load() != succ || (succ != nil && succ != victim && succ.marked.Load()) {
+			return false, unlock
+		}
+	}
+	return true, unlock
+}
+
+// put stores value for key, replacing an existing value only if replace is true.
+// It returns the value previously stored and whether there was one.
+func (m *ConcurrentSkipListMap[K, V]) put(key K, value V, replace bool) (V, bool) {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	topLevel := randomSkipListLevel()
+	for {
+		if found := m.find(key, &preds, &succs); found != -1 {
+			n := succs[found]
+			if !n.marked.Load() {
+				for !n.fullyLinked.Load() {
+					// Another goroutine is still linking the node in.
+					runtime.Gosched()
+				}
+				if !replace {
+					return *n.value.Load(), true
+				}
+				// Swap under the node's lock so that a concurrent Delete either sees the new value or makes us retry.
+				n.mu.Lock()
+				if !n.marked.Load() {
+					old := *n.value.Swap(&value)
+					n.mu.Unlock()
+					return old, true
+				}
+				n.mu.Unlock()
+			}
+			// The node is being deleted; retry once it has been unlinked.
+			runtime.Gosched()
+			continue
+		}
+
+		valid, unlock := lockPreds(topLevel, &preds, &succs, nil)
+		if !valid {
+			unlock()
+			runtime.Gosched()
+			continue
+		}
+
+		n := &skipListNode[K, V]{key: key, next: make([]atomic.Pointer[skipListNode[K, V]], topLevel)}
+		n.value.Store(&value)
+		for level := 0; level < topLevel; level++ {
+			n.next[level].Store(succs[level])
+		}
+		for level := 0; level < topLevel; level++ {
+			preds[level].next[level].Store(n)
+		}
+		n.fullyLinked.Store(true)
+		unlock()
+		m.size.Add(1)
+		var zeroValue V
+		return zeroValue, false
+	}
+}
+
+// Set adds or updates the value for the given key.
+func (m *ConcurrentSkipListMap[K, V]) Set(key K, value V) {
+	m.put(key, value, true)
+}
+
+// SetIfAbsent adds the value for the given key unless the key is already present.
+// It returns the value stored for the key afterwards and whether the key was already present.
+func (m *ConcurrentSkipListMap[K, V]) SetIfAbsent(key K, value V) (V, bool) {
+	if existing, loaded := m.put(key, value, false); loaded {
+		return existing, true
+	}
+	return value, false
+}
+
+// Get retrieves the value for the given key.
+func (m *ConcurrentSkipListMap[K, V]) Get(key K) (V, bool) {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	if found := m.find(key, &preds, &succs); found != -1 && succs[found].live() {
+		return *succs[found].value.Load(), true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// ContainsKey checks if the given key is present.
+func (m *ConcurrentSkipListMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Delete removes the given key and returns its value.
+// The boolean result is false if the key was not present.
+func (m *ConcurrentSkipListMap[K, V]) Delete(key K) (V, bool) {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	found := m.find(key, &preds, &succs)
+	if found == -1 {
+		var zeroValue V
+		return zeroValue, false
+	}
+	n := succs[found]
+	// Only a node found on its top level is fully linked in; a lower hit is still being inserted.
+	if !n.fullyLinked.Load() || len(n.next)-1 != found || !m.mark(n) {
+		var zeroValue V
+		return zeroValue, false
+	}
+	m.unlink(n)
+	return *n.value.Load(), true
+}
+
+// mark logically deletes n and reports whether this call was the one to do so.
+func (m *ConcurrentSkipListMap[K, V]) mark(n *skipListNode[K, V]) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.marked.Load() {
+		return false
+	}
+	n.marked.Store(true)
+	return true
+}
+
+// unlink physically removes the marked node n from every level.
+func (m *ConcurrentSkipListMap[K, V]) unlink(n *skipListNode[K, V]) {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	topLevel := len(n.next)
+	for {
+		m.find(n.key, &preds, &succs)
+		valid, unlock := lockPreds(topLevel, &preds, &succs, n)
+		if valid && succs[0] == n {
+			// n is marked, so no insert can change its successors any more.
+			for level := topLevel - 1; level >= 0; level-- {
+				preds[level].next[level].Store(n.next[level].Load())
+			}
+			unlock()
+			m.size.Add(-1)
+			return
+		}
+		unlock()
+		// A neighbouring node is being changed; let its owner finish first.
+		runtime.Gosched()
+	}
+}
+
+// Count returns the number of keys in the map.
+// Under concurrent modification the result is only an estimate.
+func (m *ConcurrentSkipListMap[K, V]) Count() int {
+	return int(m.size.Load())
+}
+
+// ceilingNode returns the first live node whose key is at least key.
+func (m *ConcurrentSkipListMap[K, V]) ceilingNode(key K) *skipListNode[K, V] {
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	m.find(key, &preds, &succs)
+	n := succs[0]
+	for n != nil && !n.live() {
+		n = n.next[0].Load()
+	}
+	return n
+}
+
+// firstNode returns the first live node.
+func (m *ConcurrentSkipListMap[K, V]) firstNode() *skipListNode[K, V] {
+	n := m.head.next[0].Load()
+	for n != nil && !n.live() {
+		n = n.next[0].Load()
+	}
+	return n
+}
+
+// floorNode returns the last live node whose key is at most key, or before key if inclusive is false.
+func (m *ConcurrentSkipListMap[K, V]) floorNode(key K, inclusive bool) *skipListNode[K, V] {
+	for {
+		var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+		m.find(key, &preds, &succs)
+		if n := succs[0]; inclusive && n != nil && m.compare(n.key, key) == 0 && n.live() {
+			return n
+		}
+		pred := preds[0]
+		if pred == m.head {
+			return nil
+		}
+		if pred.live() {
+			return pred
+		}
+		// The candidate is being inserted or deleted; search again for a settled one.
+		key, inclusive = pred.key, false
+	}
+}
+
+func nodeEntry[K any, V any](n *skipListNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return n.key, *n.value.Load(), true
+}
+
+// First returns the smallest key and its value.
+// The boolean result is false if the map is empty.
+func (m *ConcurrentSkipListMap[K, V]) First() (K, V, bool) {
+	return nodeEntry(m.firstNode())
+}
+
+// Last returns the largest key and its value.
+// The boolean result is false if the map is empty.
+func (m *ConcurrentSkipListMap[K, V]) Last() (K, V, bool) {
+	pred := m.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		for curr := pred.next[level].Load(); curr != nil; curr = pred.next[level].Load() {
+			pred = curr
+		}
+	}
+	if pred == m.head {
+		return nodeEntry[K, V](nil)
+	}
+	if pred.live() {
+		return nodeEntry(pred)
+	}
+	return nodeEntry(m.floorNode(pred.key, false))
+}
+
+// Floor returns the largest key less than or equal to key, and its value.
+// The boolean result is false if there is no such key.
+func (m *ConcurrentSkipListMap[K, V]) Floor(key K) (K, V, bool) {
+	return nodeEntry(m.floorNode(key, true))
+}
+
+// Ceiling returns the smallest key greater than or equal to key, and its value.
+// The boolean result is false if there is no such key.
+func (m *ConcurrentSkipListMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return nodeEntry(m.ceilingNode(key))
+}
+
+// PollFirst removes the smallest key and returns it with its value.
+// The boolean result is false if the map is empty.
+func (m *ConcurrentSkipListMap[K, V]) PollFirst() (K, V, bool) {
+	for {
+		n := m.firstNode()
+		if n == nil {
+			return nodeEntry[K, V](nil)
+		}
+		if m.mark(n) {
+			m.unlink(n)
+			return nodeEntry(n)
+		}
+		// Another goroutine removed it first; take the next one.
+	}
+}
+
+// Ascend calls fn for every key in ascending order until fn returns false.
+func (m *ConcurrentSkipListMap[K, V]) Ascend(fn func(key K, value V) bool) {
+	m.walk(m.firstNode(), nil, fn)
+}
+
+// Range calls fn in ascending order for every key in the half-open range [from, to)
+// until fn returns false.
+//
+// Example:
+//  index := NewConcurrentSkipListMap[int, string]()
+//  index.Set(1, "a")
+//  index.Set(5, "b")
+//  index.Set(9, "c")
+//  index.Range(2, 9, func(k int, v string) bool {
+//  	fmt.Println(k, v) // Output: 5 b
+//  	return true
+//  })
+func (m *ConcurrentSkipListMap[K, V]) Range(from, to K, fn func(key K, value V) bool) {
+	m.walk(m.ceilingNode(from), &to, fn)
+}
+
+// walk visits the live nodes from n onwards whose keys are below to, if given.
+func (m *ConcurrentSkipListMap[K, V]) walk(n *skipListNode[K, V], to *K, fn func(key K, value V) bool) {
+	for ; n != nil; n = n.next[0].Load() {
+		if to != nil && m.compare(n.key, *to) >= 0 {
+			return
+		}
+		if n.live() && !fn(n.key, *n.value.Load()) {
+			return
+		}
+	}
+}
+
+// Keys returns a slice of all keys in ascending order.
+func (m *ConcurrentSkipListMap[K, V]) Keys() []K {
+	var keys []K
+	m.Ascend(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns a slice of all values in ascending order of their keys.
+func (m *ConcurrentSkipListMap[K, V]) Values() []V {
+	var values []V
+	m.Ascend(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
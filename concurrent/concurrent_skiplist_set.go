@@ -0,0 +1,95 @@
+package concurrent
+
+import "cmp"
+
+// ConcurrentSkipListSet is a thread-safe sorted set backed by a ConcurrentSkipListMap.
+// Iteration is weakly consistent, as it is for ConcurrentSkipListMap.
+type ConcurrentSkipListSet[T any] struct {
+	m *ConcurrentSkipListMap[T, struct{}]
+}
+
+// NewConcurrentSkipListSet initializes a new empty ConcurrentSkipListSet ordered by the natural order of T.
+func NewConcurrentSkipListSet[T cmp.Ordered]() *ConcurrentSkipListSet[T] {
+	return &ConcurrentSkipListSet[T]{m: NewConcurrentSkipListMap[T, struct{}]()}
+}
+
+// NewConcurrentSkipListSetFunc initializes a new empty ConcurrentSkipListSet ordered by compare.
+func NewConcurrentSkipListSetFunc[T any](compare func(a, b T) int) *ConcurrentSkipListSet[T] {
+	return &ConcurrentSkipListSet[T]{m: NewConcurrentSkipListMapFunc[T, struct{}](compare)}
+}
+
+// Add adds an item to the set.
+// Returns true if the item was added, false if it was already present.
+func (s *ConcurrentSkipListSet[T]) Add(item T) bool {
+	_, loaded := s.m.SetIfAbsent(item, struct{}{})
+	return !loaded
+}
+
+// Remove removes an item from the set.
+// Returns true if the item was removed, false if it was not present.
+func (s *ConcurrentSkipListSet[T]) Remove(item T) bool {
+	_, ok := s.m.Delete(item)
+	return ok
+}
+
+// Contains checks if an item is present in the set.
+func (s *ConcurrentSkipListSet[T]) Contains(item T) bool {
+	return s.m.ContainsKey(item)
+}
+
+// Count returns the number of items in the set.
+// Under concurrent modification the result is only an estimate.
+func (s *ConcurrentSkipListSet[T]) Count() int {
+	return s.m.Count()
+}
+
+// First returns the smallest item.
+// The boolean result is false if the set is empty.
+func (s *ConcurrentSkipListSet[T]) First() (T, bool) {
+	item, _, ok := s.m.First()
+	return item, ok
+}
+
+// Last returns the largest item.
+// The boolean result is false if the set is empty.
+func (s *ConcurrentSkipListSet[T]) Last() (T, bool) {
+	item, _, ok := s.m.Last()
+	return item, ok
+}
+
+// Floor returns the largest item less than or equal to item.
+// The boolean result is false if there is no such item.
+func (s *ConcurrentSkipListSet[T]) Floor(item T) (T, bool) {
+	floor, _, ok := s.m.Floor(item)
+	return floor, ok
+}
+
+// Ceiling returns the smallest item greater than or equal to item.
+// The boolean result is false if there is no such item.
+func (s *ConcurrentSkipListSet[T]) Ceiling(item T) (T, bool) {
+	ceiling, _, ok := s.m.Ceiling(item)
+	return ceiling, ok
+}
+
+// PollFirst removes and returns the smallest item.
+// The boolean result is false if the set is empty.
+func (s *ConcurrentSkipListSet[T]) PollFirst() (T, bool) {
+	item, _, ok := s.m.PollFirst()
+	return item, ok
+}
+
+// Ascend calls fn for every item in ascending order until fn returns false.
+func (s *ConcurrentSkipListSet[T]) Ascend(fn func(item T) bool) {
+	s.m.Ascend(func(item T, _ struct{}) bool { return fn(item) })
+}
+
+// Range calls fn in ascending order for every item in the half-open range [from, to)
+// until fn returns false.
+func (s *ConcurrentSkipListSet[T]) Range(from, to T, fn func(item T) bool) {
+	s.m.Range(from, to, func(item T, _ struct{}) bool { return fn(item) })
+}
+
+// Items returns a slice of all items in ascending order.
+func (s *ConcurrentSkipListSet[T]) Items() []T {
+	return s.m.Keys()
+}
@@ -0,0 +1,245 @@
+package concurrent
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSkipListMap(t *testing.T) {
+	m := NewConcurrentSkipListMap[int, string]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		m.Set(k, "v"+string(rune('0'+k/10)))
+	}
+	m.Set(30, "thirty")
+
+	if v, ok := m.Get(30); !ok || v != "thirty" {
+		t.Errorf("Get(30) = %q, %v; want thirty, true", v, ok)
+	}
+	if v, loaded := m.SetIfAbsent(30, "x"); !loaded || v != "thirty" {
+		t.Errorf("SetIfAbsent(30) = %q, %v; want thirty, true", v, loaded)
+	}
+	if v, loaded := m.SetIfAbsent(60, "v6"); loaded || v != "v6" {
+		t.Errorf("SetIfAbsent(60) = %q, %v; want v6, false", v, loaded)
+	}
+	if keys := m.Keys(); !sort.IntsAreSorted(keys) || len(keys) != 6 || m.Count() != 6 {
+		t.Errorf("Keys() = %v, Count() = %d; want 6 sorted keys", keys, m.Count())
+	}
+
+	entry := func(k int, _ string, ok bool) int {
+		if !ok {
+			return -1
+		}
+		return k
+	}
+	checks := []struct {
+		name      string
+		got, want int
+	}{
+		{"Floor(35)", entry(m.Floor(35)), 30},
+		{"Floor(30)", entry(m.Floor(30)), 30},
+		{"Floor(5)", entry(m.Floor(5)), -1},
+		{"Ceiling(35)", entry(m.Ceiling(35)), 40},
+		{"Ceiling(61)", entry(m.Ceiling(61)), -1},
+		{"First()", entry(m.First()), 10},
+		{"Last()", entry(m.Last()), 60},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %d; want %d", c.name, c.got, c.want)
+		}
+	}
+
+	var scanned []int
+	m.Range(20, 50, func(k int, _ string) bool {
+		scanned = append(scanned, k)
+		return true
+	})
+	if len(scanned) != 3 || scanned[0] != 20 || scanned[2] != 40 {
+		t.Errorf("Range(20, 50) visited %v; want [20 30 40]", scanned)
+	}
+
+	if v, ok := m.Delete(30); !ok || v != "thirty" {
+		t.Errorf("Delete(30) = %q, %v; want thirty, true", v, ok)
+	}
+	if _, ok := m.Delete(30); ok {
+		t.Error("Delete(30) twice returned true")
+	}
+	if k, _, ok := m.PollFirst(); !ok || k != 10 {
+		t.Errorf("PollFirst() = %d, %v; want 10, true", k, ok)
+	}
+	if k, _, _ := m.First(); k != 20 || m.Count() != 4 {
+		t.Errorf("First() = %d, Count() = %d after PollFirst; want 20, 4", k, m.Count())
+	}
+}
+
+func TestConcurrentSkipListMap_CustomOrder(t *testing.T) {
+	m := NewConcurrentSkipListMapFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Set("b", 1)
+	m.Set("A", 2)
+	m.Set("B", 3)
+
+	if keys := m.Keys(); len(keys) != 2 || keys[0] != "A" || keys[1] != "b" {
+		t.Errorf("Keys() = %v; want [A b]", keys)
+	}
+	if v, _ := m.Get("B"); v != 3 {
+		t.Errorf("Get(B) = %d; want 3", v)
+	}
+}
+
+func TestConcurrentSkipListMap_Concurrent(t *testing.T) {
+	m := NewConcurrentSkipListMap[int, int]()
+	const workers, perWorker = 8, 2000
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				k := i*workers + w
+				m.Set(k, k)
+				if i%2 == 1 {
+					if _, ok := m.Delete(k); !ok {
+						t.Errorf("Delete(%d) = false for a key this goroutine owns", k)
+					}
+				}
+			}
+		}(w)
+	}
+	// Readers scan while the writers run and must always see keys in order.
+	for r := 0; r < 2; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				prev := -1
+				m.Ascend(func(k, _ int) bool {
+					if k <= prev {
+						t.Errorf("Ascend() visited %d after %d", k, prev)
+					}
+					prev = k
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := m.Count(); count != workers*perWorker/2 {
+		t.Fatalf("Count() = %d; want %d", count, workers*perWorker/2)
+	}
+
+	// Concurrent PollFirst calls must hand out every key exactly once, smallest first per caller.
+	polled := make([][]int, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				k, _, ok := m.PollFirst()
+				if !ok {
+					return
+				}
+				polled[w] = append(polled[w], k)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	seen := map[int]bool{}
+	for _, keys := range polled {
+		if !sort.IntsAreSorted(keys) {
+			t.Error("PollFirst() returned keys out of order")
+		}
+		for _, k := range keys {
+			if seen[k] {
+				t.Fatalf("PollFirst() returned %d twice", k)
+			}
+			seen[k] = true
+		}
+	}
+	if len(seen) != workers*perWorker/2 || m.Count() != 0 {
+		t.Errorf("PollFirst() returned %d keys, leaving %d; want %d, 0", len(seen), m.Count(), workers*perWorker/2)
+	}
+}
+
+func TestConcurrentSkipListMap_SetDeleteSameKey(t *testing.T) {
+	m := NewConcurrentSkipListMap[int, int]()
+	const setters, deleters, perWorker = 4, 2, 2000
+
+	// Every value written must come back exactly once: replaced by a later Set, removed by a Delete,
+	// or left in the map at the end. A Set that loses a race with Delete would drop its value.
+	var mu sync.Mutex
+	returned := make(map[int]int)
+	record := func(v int) {
+		mu.Lock()
+		returned[v]++
+		mu.Unlock()
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < setters; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				if old, replaced := m.put(0, w*perWorker+i, true); replaced {
+					record(old)
+				}
+			}
+		}(w)
+	}
+	for w := 0; w < deleters; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				if v, ok := m.Delete(0); ok {
+					record(v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if v, ok := m.Get(0); ok {
+		record(v)
+	}
+
+	for v := 0; v < setters*perWorker; v++ {
+		if returned[v] != 1 {
+			t.Fatalf("value %d came back %d times; want 1", v, returned[v])
+		}
+	}
+}
+
+func TestConcurrentSkipListSet(t *testing.T) {
+	s := NewConcurrentSkipListSet[string]()
+	for _, item := range []string{"pear", "apple", "fig", "apple"} {
+		s.Add(item)
+	}
+	if s.Add("fig") || !s.Add("kiwi") {
+		t.Error("Add() returned the wrong result")
+	}
+	if items := s.Items(); len(items) != 4 || items[0] != "apple" || items[3] != "pear" {
+		t.Errorf("Items() = %v; want [apple fig kiwi pear]", items)
+	}
+	if item, ok := s.Floor("grape"); !ok || item != "fig" {
+		t.Errorf("Floor(grape) = %q, %v; want fig, true", item, ok)
+	}
+	if item, ok := s.Ceiling("grape"); !ok || item != "kiwi" {
+		t.Errorf("Ceiling(grape) = %q, %v; want kiwi, true", item, ok)
+	}
+	if item, ok := s.PollFirst(); !ok || item != "apple" || s.Contains("apple") {
+		t.Errorf("PollFirst() = %q, %v; want apple, true", item, ok)
+	}
+	if !s.Remove("pear") || s.Remove("pear") {
+		t.Error("Remove(pear) twice did not return true, false")
+	}
+	if item, _ := s.Last(); item != "kiwi" || s.Count() != 2 {
+		t.Errorf("Last() = %q, Count() = %d; want kiwi, 2", item, s.Count())
+	}
+}
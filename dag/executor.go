@@ -0,0 +1,309 @@
+// Package dag runs tasks that depend on each other, starting each task once all of its dependencies
+// have succeeded, with bounded parallelism.
+package dag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/VikashChauhan51/collections"
+	"github.com/VikashChauhan51/collections/graph"
+)
+
+// TaskFunc is the work done by a task. It should return promptly once ctx is canceled.
+type TaskFunc func(ctx context.Context) error
+
+// Status is the outcome of a task.
+type Status int
+
+const (
+	// Skipped means the task did not run because a dependency failed or the run was canceled,
+	// or it was stopped by that cancellation.
+	Skipped Status = iota
+	// Succeeded means the task ran and returned nil.
+	Succeeded
+	// Failed means the task ran and returned an error or panicked.
+	Failed
+)
+
+func (s Status) String() string {
+	switch s {
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	default:
+		return "skipped"
+	}
+}
+
+// TaskError is returned by Run when a task fails.
+type TaskError[K comparable] struct {
+	ID  K
+	Err error
+}
+
+func (e *TaskError[K]) Error() string {
+	return fmt.Sprintf("dag: task %v failed: %v", e.ID, e.Err)
+}
+
+func (e *TaskError[K]) Unwrap() error {
+	return e.Err
+}
+
+// TaskResult is the outcome of one task in a run.
+type TaskResult[K comparable] struct {
+	ID     K
+	Status Status
+	// Err is the task's error if it failed, or why it was skipped.
+	Err        error
+	Start, End time.Time
+}
+
+// Duration returns how long the task ran, or 0 if it was skipped before it started.
+func (r TaskResult[K]) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Executor runs a set of tasks with dependencies between them on a fixed pool of workers.
+// Tasks are started in the order they became ready: first the tasks without dependencies in the order
+// they were added, then each task once its last dependency has succeeded.
+// If a task fails, the context passed to running tasks is canceled and no further tasks are started.
+//
+// Example:
+//  ex := dag.NewExecutor[string](4)
+//  ex.AddTask("fetch", fetch)
+//  ex.AddTask("build", build)
+//  ex.AddTask("test", test)
+//  ex.AddDependency("build", "fetch")
+//  ex.AddDependency("test", "build")
+//  report, err := ex.Run(ctx)
+//  path, took := report.CriticalPath()
+type Executor[K comparable] struct {
+	workers int
+	tasks   map[K]TaskFunc
+	// dependencies has an edge from each dependency to the task that depends on it.
+	dependencies *graph.Graph[K]
+}
+
+// NewExecutor creates a new empty Executor that runs at most workers tasks at once.
+// A value of 0 or less selects runtime.GOMAXPROCS(0).
+func NewExecutor[K comparable](workers int) *Executor[K] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &Executor[K]{
+		workers:      workers,
+		tasks:        make(map[K]TaskFunc),
+		dependencies: graph.NewDirected[K](),
+	}
+}
+
+// AddTask adds a task. It panics if a task with the same id was already added.
+func (e *Executor[K]) AddTask(id K, fn TaskFunc) {
+	if _, ok := e.tasks[id]; ok {
+		panic(fmt.Sprintf("Task %v was already added.", id))
+	}
+	e.tasks[id] = fn
+	e.dependencies.AddVertex(id)
+}
+
+// AddDependency makes task wait for dependsOn to succeed before it starts.
+// It panics if either task has not been added.
+func (e *Executor[K]) AddDependency(task, dependsOn K) {
+	for _, id := range [2]K{task, dependsOn} {
+		if _, ok := e.tasks[id]; !ok {
+			panic(fmt.Sprintf("Task %v has not been added.", id))
+		}
+	}
+	e.dependencies.AddEdge(dependsOn, task)
+}
+
+// Validate checks that the dependencies are acyclic.
+// It returns a *graph.CycleError listing the tasks on a cycle, each depended on by the next, otherwise.
+func (e *Executor[K]) Validate() error {
+	_, err := e.dependencies.TopologicalSort()
+	return err
+}
+
+// Run validates the dependencies and runs every task, returning once no task is running.
+// The error is a *graph.CycleError if the dependencies have a cycle, in which case no task runs and the report is nil;
+// a *TaskError for the first task that failed; or ctx's error if ctx was canceled first.
+// A task that returns an error wrapping the cancellation error after ctx is canceled or another task fails
+// was stopped by the cancellation rather than failing, so it is reported as skipped.
+// The report holds the result of every task either way.
+func (e *Executor[K]) Run(ctx context.Context) (*Report[K], error) {
+	order, err := e.dependencies.TopologicalSort()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan K)
+	results := make(chan TaskResult[K])
+	for i := 0; i < e.workers; i++ {
+		go func() {
+			for id := range jobs {
+				results <- e.runTask(ctx, id)
+			}
+		}()
+	}
+	defer close(jobs)
+
+	report := &Report[K]{order: order, results: make(map[K]TaskResult[K], len(order)), dependsOn: e.dependencies.Reverse()}
+	remaining := make(map[K]int, len(order))
+	ready := collections.NewQueue[K]()
+	for _, id := range e.dependencies.Vertices() {
+		remaining[id] = e.dependencies.InDegree(id)
+		if remaining[id] == 0 {
+			ready.Enqueue(id)
+		}
+	}
+
+	var failure *TaskError[K]
+	// stopped records whether a running task was stopped by the cancellation of the run.
+	stopped := false
+	idle := e.workers
+	for {
+		for idle > 0 && !ready.IsEmpty() && ctx.Err() == nil {
+			jobs <- ready.Dequeue()
+			idle--
+		}
+		if idle == e.workers {
+			break
+		}
+
+		result := <-results
+		idle++
+		if result.Status == Failed && ctx.Err() != nil && errors.Is(result.Err, ctx.Err()) {
+			result.Status = Skipped
+			stopped = true
+		}
+		report.results[result.ID] = result
+		if result.Status == Failed && failure == nil {
+			failure = &TaskError[K]{ID: result.ID, Err: result.Err}
+			cancel()
+		}
+		if result.Status != Succeeded {
+			continue
+		}
+		for _, next := range e.dependencies.Neighbors(result.ID) {
+			remaining[next]--
+			if remaining[next] == 0 {
+				ready.Enqueue(next)
+			}
+		}
+	}
+
+	// Record why every task that did not run was skipped.
+	reason := ctx.Err()
+	if failure != nil {
+		reason = fmt.Errorf("dag: skipped after task %v failed", failure.ID)
+	}
+	skipped := false
+	for _, id := range order {
+		if _, ok := report.results[id]; !ok {
+			report.results[id] = TaskResult[K]{ID: id, Status: Skipped, Err: reason}
+			skipped = true
+		}
+	}
+
+	if failure != nil {
+		return report, failure
+	}
+	if skipped || stopped {
+		return report, reason
+	}
+	return report, nil
+}
+
+// runTask runs one task, turning a panic into a failure.
+func (e *Executor[K]) runTask(ctx context.Context, id K) (result TaskResult[K]) {
+	result = TaskResult[K]{ID: id, Start: time.Now()}
+	defer func() {
+		if r := recover(); r != nil {
+			result.Err = fmt.Errorf("dag: task %v panicked: %v", id, r)
+		}
+		result.End = time.Now()
+		result.Status = Succeeded
+		if result.Err != nil {
+			result.Status = Failed
+		}
+	}()
+	result.Err = e.tasks[id](ctx)
+	return result
+}
+
+// Report holds the results of a run.
+type Report[K comparable] struct {
+	// order is a topological order of the tasks.
+	order   []K
+	results map[K]TaskResult[K]
+	// dependsOn has an edge from each task to each of its dependencies.
+	dependsOn *graph.Graph[K]
+}
+
+// Result returns the result of a task.
+// The boolean result is false if no task has the id.
+func (r *Report[K]) Result(id K) (TaskResult[K], bool) {
+	result, ok := r.results[id]
+	return result, ok
+}
+
+// Results returns the results of all tasks, in an order in which every task follows its dependencies.
+func (r *Report[K]) Results() []TaskResult[K] {
+	results := make([]TaskResult[K], len(r.order))
+	for i, id := range r.order {
+		results[i] = r.results[id]
+	}
+	return results
+}
+
+// CriticalPath returns the chain of dependent tasks that ran with the longest total duration,
+// which bounds how fast the run could have been with unlimited workers, and that total.
+// Skipped tasks are not part of any chain.
+func (r *Report[K]) CriticalPath() ([]K, time.Duration) {
+	// longest[id] is the longest total duration of a chain ending at id, and previous[id] the task before id on it.
+	longest := make(map[K]time.Duration, len(r.order))
+	previous := make(map[K]K, len(r.order))
+	var end K
+	found := false
+	for _, id := range r.order {
+		result := r.results[id]
+		if result.Status == Skipped {
+			continue
+		}
+		longest[id] = result.Duration()
+		for _, dep := range r.dependsOn.Neighbors(id) {
+			if d, ran := longest[dep]; ran && d+result.Duration() > longest[id] {
+				longest[id] = d + result.Duration()
+				previous[id] = dep
+			}
+		}
+		if !found || longest[id] > longest[end] {
+			end, found = id, true
+		}
+	}
+	if !found {
+		return nil, 0
+	}
+
+	path := []K{end}
+	for id := end; ; {
+		dep, ok := previous[id]
+		if !ok {
+			break
+		}
+		path = append(path, dep)
+		id = dep
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, longest[end]
+}
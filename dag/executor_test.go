@@ -0,0 +1,234 @@
+package dag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/VikashChauhan51/collections/graph"
+)
+
+// recorder returns tasks that log their id when they run and sleep for a while.
+type recorder struct {
+	mu  sync.Mutex
+	ran []string
+}
+
+func (r *recorder) task(id string, sleep time.Duration) TaskFunc {
+	return func(ctx context.Context) error {
+		time.Sleep(sleep)
+		r.mu.Lock()
+		r.ran = append(r.ran, id)
+		r.mu.Unlock()
+		return nil
+	}
+}
+
+func (r *recorder) index(id string) int {
+	for i, v := range r.ran {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestExecutor_RunsDependenciesFirst(t *testing.T) {
+	rec := &recorder{}
+	ex := NewExecutor[string](3)
+	ex.AddTask("fetch", rec.task("fetch", time.Millisecond))
+	ex.AddTask("lint", rec.task("lint", time.Millisecond))
+	ex.AddTask("build", rec.task("build", 30*time.Millisecond))
+	ex.AddTask("docs", rec.task("docs", time.Millisecond))
+	ex.AddTask("test", rec.task("test", time.Millisecond))
+	ex.AddDependency("build", "fetch")
+	ex.AddDependency("docs", "fetch")
+	ex.AddDependency("test", "build")
+	ex.AddDependency("test", "lint")
+
+	report, err := ex.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	for _, dep := range [][2]string{{"fetch", "build"}, {"fetch", "docs"}, {"build", "test"}, {"lint", "test"}} {
+		if rec.index(dep[0]) > rec.index(dep[1]) {
+			t.Errorf("%s ran after %s in %v", dep[0], dep[1], rec.ran)
+		}
+	}
+	if len(rec.ran) != 5 {
+		t.Fatalf("%d tasks ran; want 5", len(rec.ran))
+	}
+	for _, result := range report.Results() {
+		if result.Status != Succeeded || result.Err != nil {
+			t.Errorf("task %s: %v, %v; want succeeded", result.ID, result.Status, result.Err)
+		}
+	}
+
+	path, took := report.CriticalPath()
+	if len(path) != 3 || path[0] != "fetch" || path[1] != "build" || path[2] != "test" {
+		t.Errorf("CriticalPath() = %v; want [fetch build test]", path)
+	}
+	if took < 30*time.Millisecond {
+		t.Errorf("critical path took %v; want at least 30ms", took)
+	}
+}
+
+func TestExecutor_BoundsParallelism(t *testing.T) {
+	var running, peak atomic.Int32
+	ex := NewExecutor[int](2)
+	for i := 0; i < 8; i++ {
+		ex.AddTask(i, func(ctx context.Context) error {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return nil
+		})
+	}
+	if _, err := ex.Run(context.Background()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if p := peak.Load(); p != 2 {
+		t.Errorf("peak parallelism = %d; want 2", p)
+	}
+}
+
+func TestExecutor_FailureCancelsAndSkips(t *testing.T) {
+	boom := errors.New("boom")
+	canceled := make(chan struct{})
+	ex := NewExecutor[string](2)
+	ex.AddTask("slow", func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			close(canceled)
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+			return nil
+		}
+	})
+	ex.AddTask("bad", func(ctx context.Context) error { return boom })
+	ex.AddTask("after", func(ctx context.Context) error { return nil })
+	ex.AddDependency("after", "bad")
+
+	report, err := ex.Run(context.Background())
+	var taskErr *TaskError[string]
+	if !errors.As(err, &taskErr) || taskErr.ID != "bad" || !errors.Is(err, boom) {
+		t.Fatalf("Run() error = %v; want a TaskError for bad wrapping boom", err)
+	}
+	select {
+	case <-canceled:
+	default:
+		t.Error("the running task's context was not canceled")
+	}
+	if r, _ := report.Result("after"); r.Status != Skipped || r.Err == nil {
+		t.Errorf("after: %v, %v; want skipped with a reason", r.Status, r.Err)
+	}
+	if r, _ := report.Result("bad"); r.Status != Failed {
+		t.Errorf("bad: %v; want failed", r.Status)
+	}
+	if r, _ := report.Result("slow"); r.Status != Skipped || !errors.Is(r.Err, context.Canceled) {
+		t.Errorf("slow: %v, %v; want skipped by the cancellation", r.Status, r.Err)
+	}
+}
+
+func TestExecutor_PanicIsFailure(t *testing.T) {
+	ex := NewExecutor[string](1)
+	ex.AddTask("panics", func(ctx context.Context) error { panic("oops") })
+	report, err := ex.Run(context.Background())
+	if err == nil {
+		t.Fatal("Run() error = nil; want the panic reported")
+	}
+	if r, _ := report.Result("panics"); r.Status != Failed {
+		t.Errorf("panics: %v; want failed", r.Status)
+	}
+}
+
+func TestExecutor_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ex := NewExecutor[string](0)
+	ex.AddTask("never", func(ctx context.Context) error { t.Error("task ran after cancellation"); return nil })
+	report, err := ex.Run(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() error = %v; want context.Canceled", err)
+	}
+	if r, _ := report.Result("never"); r.Status != Skipped {
+		t.Errorf("never: %v; want skipped", r.Status)
+	}
+	if path, _ := report.CriticalPath(); path != nil {
+		t.Errorf("CriticalPath() = %v; want nil", path)
+	}
+}
+
+func TestExecutor_CanceledWhileRunning(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	started := make(chan struct{})
+	ex := NewExecutor[string](2)
+	ex.AddTask("first", func(ctx context.Context) error { return nil })
+	ex.AddTask("slow", func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return fmt.Errorf("slow: %w", ctx.Err())
+	})
+	ex.AddTask("after", func(ctx context.Context) error { t.Error("task ran after cancellation"); return nil })
+	ex.AddDependency("after", "slow")
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	report, err := ex.Run(ctx)
+	var taskErr *TaskError[string]
+	if !errors.Is(err, context.Canceled) || errors.As(err, &taskErr) {
+		t.Fatalf("Run() error = %v; want context.Canceled", err)
+	}
+	for id, want := range map[string]Status{"first": Succeeded, "slow": Skipped, "after": Skipped} {
+		if r, _ := report.Result(id); r.Status != want {
+			t.Errorf("%s: %v; want %v", id, r.Status, want)
+		}
+	}
+}
+
+func TestExecutor_ReportsCycle(t *testing.T) {
+	noop := func(ctx context.Context) error { return nil }
+	ex := NewExecutor[string](2)
+	for _, id := range []string{"a", "b", "c"} {
+		ex.AddTask(id, noop)
+	}
+	ex.AddDependency("b", "a")
+	ex.AddDependency("c", "b")
+	if err := ex.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	ex.AddDependency("a", "c")
+
+	report, err := ex.Run(context.Background())
+	var cycleErr *graph.CycleError[string]
+	if !errors.As(err, &cycleErr) || report != nil {
+		t.Fatalf("Run() = %v, %v; want a nil report and a CycleError", report, err)
+	}
+	if len(cycleErr.Cycle) != 3 {
+		t.Errorf("Cycle = %v; want all three tasks", cycleErr.Cycle)
+	}
+}
+
+func TestExecutor_PanicsOnUnknownTask(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for an unknown dependency, but did not panic")
+		}
+	}()
+	ex := NewExecutor[string](1)
+	ex.AddTask("a", func(ctx context.Context) error { return nil })
+	ex.AddDependency("a", "missing")
+}
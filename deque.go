@@ -0,0 +1,256 @@
+package collections
+
+// minDequeCapacity is the smallest backing array a non-empty Deque allocates.
+const minDequeCapacity = 16
+
+// Deque represents a generic double-ended queue backed by a growable ring buffer.
+// Pushing and popping at either end run in amortised O(1); the ring grows by doubling
+// when full and shrinks by half when it becomes a quarter full.
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewDeque creates a new instance of a Deque.
+//
+// Example:
+//  d := NewDeque[int]()
+//  d.PushBack(2)
+//  d.PushFront(1)
+//  fmt.Println(d.ToSlice()) // Output: [1 2]
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// PushFront adds an element to the front of the deque.
+func (d *Deque[T]) PushFront(element T) {
+	d.grow()
+	d.head = d.wrap(d.head - 1)
+	d.buf[d.head] = element
+	d.size++
+}
+
+// PushBack adds an element to the back of the deque.
+func (d *Deque[T]) PushBack(element T) {
+	d.grow()
+	d.buf[d.wrap(d.head+d.size)] = element
+	d.size++
+}
+
+// PopFront removes and returns the element at the front of the deque.
+// It panics if the deque is empty.
+func (d *Deque[T]) PopFront() T {
+	if d.size == 0 {
+		panic("PopFront from an empty deque")
+	}
+
+	var zeroValue T
+	element := d.buf[d.head]
+	d.buf[d.head] = zeroValue
+	d.head = d.wrap(d.head + 1)
+	d.size--
+	d.shrink()
+	return element
+}
+
+// PopBack removes and returns the element at the back of the deque.
+// It panics if the deque is empty.
+func (d *Deque[T]) PopBack() T {
+	if d.size == 0 {
+		panic("PopBack from an empty deque")
+	}
+
+	var zeroValue T
+	i := d.wrap(d.head + d.size - 1)
+	element := d.buf[i]
+	d.buf[i] = zeroValue
+	d.size--
+	d.shrink()
+	return element
+}
+
+// PeekFront returns the element at the front of the deque without removing it.
+// It panics if the deque is empty.
+func (d *Deque[T]) PeekFront() T {
+	if d.size == 0 {
+		panic("PeekFront from an empty deque")
+	}
+
+	return d.buf[d.head]
+}
+
+// PeekBack returns the element at the back of the deque without removing it.
+// It panics if the deque is empty.
+func (d *Deque[T]) PeekBack() T {
+	if d.size == 0 {
+		panic("PeekBack from an empty deque")
+	}
+
+	return d.buf[d.wrap(d.head+d.size-1)]
+}
+
+// Get retrieves the element at the specified index, counted from the front.
+func (d *Deque[T]) Get(index int) T {
+	if index < 0 || index >= d.size {
+		panic("Index out of range.")
+	}
+	return d.buf[d.wrap(d.head+index)]
+}
+
+// Set updates the element at the specified index, counted from the front.
+func (d *Deque[T]) Set(index int, element T) {
+	if index < 0 || index >= d.size {
+		panic("Index out of range.")
+	}
+	d.buf[d.wrap(d.head+index)] = element
+}
+
+// Rotate rotates the deque n steps to the right, moving the last n elements to the front.
+// A negative n rotates to the left.
+//
+// Example:
+//  d := NewDeque[int]()
+//  for i := 1; i <= 5; i++ {
+//  	d.PushBack(i)
+//  }
+//  d.Rotate(2)
+//  fmt.Println(d.ToSlice()) // Output: [4 5 1 2 3]
+func (d *Deque[T]) Rotate(n int) {
+	if d.size <= 1 {
+		return
+	}
+	n %= d.size
+	if n < 0 {
+		n += d.size
+	}
+	if n == 0 {
+		return
+	}
+
+	if d.size == len(d.buf) {
+		// A full ring rotates by moving the head alone.
+		d.head = d.wrap(d.head - n)
+		return
+	}
+
+	var zeroValue T
+	if n <= d.size/2 {
+		for i := 0; i < n; i++ {
+			back := d.wrap(d.head + d.size - 1)
+			d.head = d.wrap(d.head - 1)
+			d.buf[d.head] = d.buf[back]
+			d.buf[back] = zeroValue
+		}
+	} else {
+		for i := 0; i < d.size-n; i++ {
+			d.buf[d.wrap(d.head+d.size)] = d.buf[d.head]
+			d.buf[d.head] = zeroValue
+			d.head = d.wrap(d.head + 1)
+		}
+	}
+}
+
+// Clear removes all elements from the deque and releases its backing array.
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.size = 0
+}
+
+// IsEmpty returns true if the deque is empty, false otherwise.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Size returns the number of elements in the deque.
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// ToSlice returns the elements of the deque from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	slice := make([]T, d.size)
+	d.copyTo(slice)
+	return slice
+}
+
+// copyTo copies the elements from front to back into dst, which must hold at least Size elements.
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.size == 0 {
+		return
+	}
+	end := d.head + d.size
+	if end <= len(d.buf) {
+		copy(dst, d.buf[d.head:end])
+		return
+	}
+	n := copy(dst, d.buf[d.head:])
+	copy(dst[n:], d.buf[:end-len(d.buf)])
+}
+
+func (d *Deque[T]) wrap(i int) int {
+	return i & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	capacity := len(d.buf) * 2
+	if capacity == 0 {
+		capacity = minDequeCapacity
+	}
+	d.resize(capacity)
+}
+
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCapacity && d.size <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	d.copyTo(buf)
+	d.buf = buf
+	d.head = 0
+}
+
+// DequeIterator represents an iterator over a Deque in either direction.
+type DequeIterator[T any] struct {
+	deque   *Deque[T]
+	index   int
+	reverse bool
+}
+
+// NewIterator creates a new iterator that walks the deque from front to back.
+func (d *Deque[T]) NewIterator() *DequeIterator[T] {
+	return &DequeIterator[T]{deque: d, index: 0}
+}
+
+// NewReverseIterator creates a new iterator that walks the deque from back to front.
+func (d *Deque[T]) NewReverseIterator() *DequeIterator[T] {
+	return &DequeIterator[T]{deque: d, index: d.Size() - 1, reverse: true}
+}
+
+// HasNext checks if there are more elements to iterate over.
+func (it *DequeIterator[T]) HasNext() bool {
+	return it.index >= 0 && it.index < it.deque.Size()
+}
+
+// Next returns the next element in the iteration.
+func (it *DequeIterator[T]) Next() (T, bool) {
+	if it.HasNext() {
+		item := it.deque.Get(it.index)
+		if it.reverse {
+			it.index--
+		} else {
+			it.index++
+		}
+		return item, true
+	}
+	var zeroValue T
+	return zeroValue, false
+}
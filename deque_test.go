@@ -0,0 +1,145 @@
+package collections
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func assertSliceEqual[T comparable](t *testing.T, got, want []T) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v; want %v", got, want)
+		}
+	}
+}
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+
+	assertSliceEqual(t, d.ToSlice(), []int{0, 1, 2, 3})
+	if front, back := d.PeekFront(), d.PeekBack(); front != 0 || back != 3 {
+		t.Errorf("PeekFront(), PeekBack() = %d, %d; want 0, 3", front, back)
+	}
+	if v := d.PopFront(); v != 0 {
+		t.Errorf("PopFront() = %d; want 0", v)
+	}
+	if v := d.PopBack(); v != 3 {
+		t.Errorf("PopBack() = %d; want 3", v)
+	}
+
+	d.Set(1, 20)
+	if v := d.Get(1); v != 20 {
+		t.Errorf("Get(1) = %d; want 20", v)
+	}
+
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Error("IsEmpty() after Clear() = false; want true")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when popping an empty deque, but did not panic")
+		}
+	}()
+	d.PopBack()
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	d := NewDeque[int]()
+	for i := 1; i <= 5; i++ {
+		d.PushBack(i)
+	}
+
+	d.Rotate(2)
+	assertSliceEqual(t, d.ToSlice(), []int{4, 5, 1, 2, 3})
+	d.Rotate(-3)
+	assertSliceEqual(t, d.ToSlice(), []int{2, 3, 4, 5, 1})
+	d.Rotate(11)
+	assertSliceEqual(t, d.ToSlice(), []int{1, 2, 3, 4, 5})
+
+	full := NewDeque[int]()
+	for i := 0; i < minDequeCapacity; i++ {
+		full.PushBack(i)
+	}
+	full.Rotate(1)
+	if v := full.PeekFront(); v != minDequeCapacity-1 {
+		t.Errorf("PeekFront() after Rotate(1) on full ring = %d; want %d", v, minDequeCapacity-1)
+	}
+}
+
+func TestDeque_GrowAndShrink(t *testing.T) {
+	d := NewDeque[int]()
+	r := rand.New(rand.NewSource(3))
+	var reference []int
+
+	for i := 0; i < 10000; i++ {
+		switch r.Intn(4) {
+		case 0:
+			d.PushBack(i)
+			reference = append(reference, i)
+		case 1:
+			d.PushFront(i)
+			reference = append([]int{i}, reference...)
+		case 2:
+			if len(reference) > 0 {
+				if v := d.PopFront(); v != reference[0] {
+					t.Fatalf("PopFront() = %d; want %d", v, reference[0])
+				}
+				reference = reference[1:]
+			}
+		case 3:
+			if len(reference) > 0 {
+				if v := d.PopBack(); v != reference[len(reference)-1] {
+					t.Fatalf("PopBack() = %d; want %d", v, reference[len(reference)-1])
+				}
+				reference = reference[:len(reference)-1]
+			}
+		}
+	}
+	assertSliceEqual(t, d.ToSlice(), reference)
+
+	for i := 0; i < 1000; i++ {
+		d.PushBack(i)
+	}
+	for !d.IsEmpty() {
+		d.PopFront()
+	}
+	if len(d.buf) != minDequeCapacity {
+		t.Errorf("capacity after draining = %d; want %d", len(d.buf), minDequeCapacity)
+	}
+}
+
+func TestDeque_Iterators(t *testing.T) {
+	d := NewDeque[string]()
+	d.PushBack("b")
+	d.PushBack("c")
+	d.PushFront("a")
+
+	var forward []string
+	for it := d.NewIterator(); it.HasNext(); {
+		item, _ := it.Next()
+		forward = append(forward, item)
+	}
+	assertSliceEqual(t, forward, []string{"a", "b", "c"})
+
+	var backward []string
+	it := d.NewReverseIterator()
+	for it.HasNext() {
+		item, _ := it.Next()
+		backward = append(backward, item)
+	}
+	assertSliceEqual(t, backward, []string{"c", "b", "a"})
+
+	if _, ok := it.Next(); ok {
+		t.Error("Next() after exhaustion returned true; want false")
+	}
+}
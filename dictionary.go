@@ -1,58 +1,78 @@
 package collections
 
-// Dictionary is a generic type that holds a map of items with keys and values of any comparable types.
-type Dictionary[K comparable, V any] struct {
-    items map[K]V
+// Dictionary is a generic type that holds a map of items with keys and values of any types.
+type Dictionary[K any, V any] struct {
+	items hashStore[K, V]
 }
 
 // NewDictionary initializes a new empty Dictionary.
 func NewDictionary[K comparable, V any]() *Dictionary[K, V] {
-    return &Dictionary[K, V]{
-        items: make(map[K]V),
-    }
+	return &Dictionary[K, V]{
+		items: newMapStore[K, V](),
+	}
+}
+
+// NewDictionaryWithComparer initializes a new empty Dictionary that uses hasher to compare and hash keys.
+// The key type does not need to be comparable.
+//
+// Example:
+//  headers := NewDictionaryWithComparer[string, string](IgnoreCaseComparer())
+//  headers.Set("Content-Type", "text/plain")
+//  fmt.Println(headers.Get("content-type")) // Output: text/plain true
+func NewDictionaryWithComparer[K any, V any](hasher Hasher[K]) *Dictionary[K, V] {
+	return &Dictionary[K, V]{
+		items: newChainedTable[K, V](hasher),
+	}
+}
+
+// NewDictionaryOpenAddressing initializes a new empty Dictionary backed by an open-addressing
+// (Robin Hood) hash table instead of a Go map. The table grows once it is more than maxLoadFactor
+// full (a value of 0 selects 0.85), and Keys and Values return entries in an order that is
+// deterministic for a seeded hasher.
+func NewDictionaryOpenAddressing[K any, V any](hasher Hasher[K], maxLoadFactor float64) *Dictionary[K, V] {
+	return &Dictionary[K, V]{
+		items: newRobinHoodTable[K, V](hasher, maxLoadFactor),
+	}
 }
 
 // Set adds or updates a key-value pair in the Dictionary.
 func (d *Dictionary[K, V]) Set(key K, value V) {
-    d.items[key] = value
+	d.items.set(key, value)
 }
 
 // Get retrieves the value for a given key from the Dictionary.
 // Returns the value and a boolean indicating if the key was found.
 func (d *Dictionary[K, V]) Get(key K) (V, bool) {
-    value, ok := d.items[key]
-    return value, ok
+	return d.items.get(key)
 }
 
 // Remove removes a key-value pair from the Dictionary by key.
 // Returns true if the item was removed, false otherwise.
 func (d *Dictionary[K, V]) Remove(key K) bool {
-    _, ok := d.items[key]
-    if ok {
-        delete(d.items, key)
-    }
-    return ok
+	return d.items.remove(key)
 }
 
 // Keys returns a slice of all keys in the Dictionary.
 func (d *Dictionary[K, V]) Keys() []K {
-    keys := make([]K, 0, len(d.items))
-    for k := range d.items {
-        keys = append(keys, k)
-    }
-    return keys
+	keys := make([]K, 0, d.items.len())
+	d.items.forEach(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
 }
 
 // Values returns a slice of all values in the Dictionary.
 func (d *Dictionary[K, V]) Values() []V {
-    values := make([]V, 0, len(d.items))
-    for _, v := range d.items {
-        values = append(values, v)
-    }
-    return values
+	values := make([]V, 0, d.items.len())
+	d.items.forEach(func(_ K, v V) bool {
+		values = append(values, v)
+		return true
+	})
+	return values
 }
 
 // Count returns the number of key-value pairs in the Dictionary.
 func (d *Dictionary[K, V]) Count() int {
-    return len(d.items)
+	return d.items.len()
 }
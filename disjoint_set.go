@@ -0,0 +1,171 @@
+package collections
+
+// DisjointSet partitions items into disjoint sets that can be merged, and finds the set an item belongs to
+// in nearly constant amortized time using union by size and path compression.
+// Each set is identified by one of its members, its representative.
+//
+// Example:
+//  groups := NewDisjointSet[string]()
+//  groups.Union("a", "b")
+//  groups.Union("c", "d")
+//  groups.Union("b", "d")
+//  fmt.Println(groups.Connected("a", "c")) // Output: true
+//  fmt.Println(groups.SetCount())          // Output: 1
+type DisjointSet[T comparable] struct {
+	// index maps each item to its position in items, parent and size.
+	index  map[T]int
+	items  []T
+	parent []int
+	// size[i] is the number of items in the set if i is a representative.
+	size     []int
+	setCount int
+}
+
+// NewDisjointSet creates a new empty DisjointSet.
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{index: make(map[T]int)}
+}
+
+// MakeSet adds item in a set of its own.
+// Returns true if the item was added, false if it was already present.
+func (d *DisjointSet[T]) MakeSet(item T) bool {
+	if _, ok := d.index[item]; ok {
+		return false
+	}
+	d.makeSet(item)
+	return true
+}
+
+func (d *DisjointSet[T]) makeSet(item T) int {
+	i := len(d.items)
+	d.index[item] = i
+	d.items = append(d.items, item)
+	d.parent = append(d.parent, i)
+	d.size = append(d.size, 1)
+	d.setCount++
+	return i
+}
+
+// root returns the position of the representative of the item at position i, compressing the path to it.
+func (d *DisjointSet[T]) root(i int) int {
+	r := i
+	for d.parent[r] != r {
+		r = d.parent[r]
+	}
+	for d.parent[i] != r {
+		d.parent[i], i = r, d.parent[i]
+	}
+	return r
+}
+
+// Find returns the representative of the set containing item.
+// The boolean result is false if the item is not present.
+func (d *DisjointSet[T]) Find(item T) (T, bool) {
+	i, ok := d.index[item]
+	if !ok {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.items[d.root(i)], true
+}
+
+// Union merges the sets containing a and b, adding either item in a set of its own first if it is not present.
+// Returns true if the sets were merged, false if a and b were already in the same set.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	ra, rb := d.root(d.indexOf(a)), d.root(d.indexOf(b))
+	if ra == rb {
+		return false
+	}
+	// Attach the smaller set under the larger one to keep paths short.
+	if d.size[ra] < d.size[rb] {
+		ra, rb = rb, ra
+	}
+	d.parent[rb] = ra
+	d.size[ra] += d.size[rb]
+	d.setCount--
+	return true
+}
+
+func (d *DisjointSet[T]) indexOf(item T) int {
+	if i, ok := d.index[item]; ok {
+		return i
+	}
+	return d.makeSet(item)
+}
+
+// Connected checks if a and b are present and in the same set.
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, ok := d.index[a]
+	j, ok2 := d.index[b]
+	return ok && ok2 && d.root(i) == d.root(j)
+}
+
+// Contains checks if an item is present.
+func (d *DisjointSet[T]) Contains(item T) bool {
+	_, ok := d.index[item]
+	return ok
+}
+
+// SetSize returns the number of items in the set containing item, or 0 if the item is not present.
+func (d *DisjointSet[T]) SetSize(item T) int {
+	i, ok := d.index[item]
+	if !ok {
+		return 0
+	}
+	return d.size[d.root(i)]
+}
+
+// SetCount returns the number of disjoint sets.
+func (d *DisjointSet[T]) SetCount() int {
+	return d.setCount
+}
+
+// Count returns the number of items in all sets.
+func (d *DisjointSet[T]) Count() int {
+	return len(d.items)
+}
+
+// Clear removes all items.
+func (d *DisjointSet[T]) Clear() {
+	d.index = make(map[T]int)
+	d.items = nil
+	d.parent = nil
+	d.size = nil
+	d.setCount = 0
+}
+
+// Members returns the items in the set containing item, in the order they were added,
+// or nil if the item is not present.
+func (d *DisjointSet[T]) Members(item T) []T {
+	i, ok := d.index[item]
+	if !ok {
+		return nil
+	}
+	r := d.root(i)
+	members := make([]T, 0, d.size[r])
+	for j, member := range d.items {
+		if d.root(j) == r {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// Sets returns the members of every set. Sets are ordered by their earliest added item,
+// and the members of each set are in the order they were added.
+func (d *DisjointSet[T]) Sets() [][]T {
+	sets := make([][]T, 0, d.setCount)
+	// position maps a representative to the index of its set in sets.
+	position := make(map[int]int, d.setCount)
+	for j, item := range d.items {
+		r := d.root(j)
+		p, ok := position[r]
+		if !ok {
+			p = len(sets)
+			position[r] = p
+			sets = append(sets, make([]T, 0, d.size[r]))
+		}
+		sets[p] = append(sets[p], item)
+	}
+	return sets
+}
@@ -0,0 +1,115 @@
+package collections
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDisjointSet(t *testing.T) {
+	set := NewDisjointSet[string]()
+	if !set.MakeSet("a") || set.MakeSet("a") {
+		t.Error("MakeSet(a) did not add exactly once")
+	}
+	if !set.Union("a", "b") || !set.Union("c", "d") || set.Union("b", "a") {
+		t.Error("Union returned an unexpected result")
+	}
+	set.MakeSet("e")
+
+	if set.Count() != 5 || set.SetCount() != 3 {
+		t.Errorf("Count() = %d, SetCount() = %d; want 5, 3", set.Count(), set.SetCount())
+	}
+	if !set.Connected("a", "b") || set.Connected("a", "c") || set.Connected("a", "z") {
+		t.Error("Connected returned an unexpected result")
+	}
+	if ra, _ := set.Find("a"); ra != mustFind(t, set, "b") {
+		t.Error("a and b have different representatives")
+	}
+	if _, ok := set.Find("z"); ok {
+		t.Error("Find(z) = true; want false")
+	}
+
+	set.Union("d", "a")
+	if set.SetSize("c") != 4 || set.SetSize("e") != 1 || set.SetSize("z") != 0 {
+		t.Errorf("SetSize returned %d, %d, %d; want 4, 1, 0", set.SetSize("c"), set.SetSize("e"), set.SetSize("z"))
+	}
+	assertSliceEqual(t, set.Members("d"), []string{"a", "b", "c", "d"})
+	sets := set.Sets()
+	if len(sets) != 2 {
+		t.Fatalf("Sets() returned %d sets; want 2", len(sets))
+	}
+	assertSliceEqual(t, sets[0], []string{"a", "b", "c", "d"})
+	assertSliceEqual(t, sets[1], []string{"e"})
+
+	set.Clear()
+	if set.Count() != 0 || set.SetCount() != 0 || set.Contains("a") {
+		t.Error("Clear() did not empty the set")
+	}
+}
+
+func mustFind[T comparable](t *testing.T, set *DisjointSet[T], item T) T {
+	t.Helper()
+	r, ok := set.Find(item)
+	if !ok {
+		t.Fatalf("Find(%v) = false; want true", item)
+	}
+	return r
+}
+
+func TestDisjointSet_StructKeys(t *testing.T) {
+	type point struct{ x, y int }
+	grid := NewDisjointSet[point]()
+	grid.Union(point{0, 0}, point{0, 1})
+	grid.Union(point{5, 5}, point{5, 6})
+	grid.Union(point{0, 1}, point{1, 1})
+	if !grid.Connected(point{0, 0}, point{1, 1}) || grid.Connected(point{0, 0}, point{5, 6}) {
+		t.Error("Connected returned an unexpected result")
+	}
+}
+
+func TestDisjointSet_RandomOperations(t *testing.T) {
+	const n = 200
+	r := rand.New(rand.NewSource(17))
+	set := NewDisjointSet[int]()
+	// label is a naive reference that relabels a whole component on every union.
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+		set.MakeSet(i)
+	}
+	components := n
+
+	for i := 0; i < 300; i++ {
+		a, b := r.Intn(n), r.Intn(n)
+		merged := label[a] != label[b]
+		if got := set.Union(a, b); got != merged {
+			t.Fatalf("Union(%d, %d) = %v; want %v", a, b, got, merged)
+		}
+		if merged {
+			components--
+			old := label[b]
+			for j := range label {
+				if label[j] == old {
+					label[j] = label[a]
+				}
+			}
+		}
+	}
+
+	if set.SetCount() != components {
+		t.Errorf("SetCount() = %d; want %d", set.SetCount(), components)
+	}
+	for a := 0; a < n; a++ {
+		size := 0
+		for b := 0; b < n; b++ {
+			if set.Connected(a, b) != (label[a] == label[b]) {
+				t.Fatalf("Connected(%d, %d) = %v", a, b, set.Connected(a, b))
+			}
+			if label[a] == label[b] {
+				size++
+			}
+		}
+		if set.SetSize(a) != size {
+			t.Fatalf("SetSize(%d) = %d; want %d", a, set.SetSize(a), size)
+		}
+	}
+}
@@ -1,79 +1,191 @@
 package collections
 
-// Node represents a node in the doubly linked list.
-type DoublyNode[T comparable] struct {
+// DoublyNode is a node of a DoublyLinkedList and the handle returned by the Push*, Insert*, Front, Back and Find operations.
+// It can be passed back to RemoveNode, the Move* operations, InsertBefore and InsertAfter for O(1) updates.
+type DoublyNode[T any] struct {
 	Value T
 	Prev  *DoublyNode[T]
 	Next  *DoublyNode[T]
+	list  *DoublyLinkedList[T]
 }
 
 // DoublyLinkedList represents a generic doubly linked list.
-type DoublyLinkedList[T comparable] struct {
-	head *DoublyNode[T]
-	tail *DoublyNode[T]
-	size int
+// Nodes returned by its methods act as handles: they can be removed, moved or
+// used as insertion points in O(1).
+type DoublyLinkedList[T any] struct {
+	head     *DoublyNode[T]
+	tail     *DoublyNode[T]
+	size     int
+	comparer EqualityComparer[T]
 }
 
 // NewDoublyLinkedList creates a new instance of a DoublyLinkedList.
 func NewDoublyLinkedList[T comparable]() *DoublyLinkedList[T] {
-	return &DoublyLinkedList[T]{}
+	return &DoublyLinkedList[T]{comparer: DefaultComparer[T]()}
+}
+
+// NewDoublyLinkedListWithComparer creates a new instance of a DoublyLinkedList that compares values with comparer.
+func NewDoublyLinkedListWithComparer[T any](comparer EqualityComparer[T]) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{comparer: comparer}
 }
 
 // Add adds an element to the end of the doubly linked list.
 func (dll *DoublyLinkedList[T]) Add(value T) {
-	newNode := &DoublyNode[T]{Value: value}
+	dll.PushBack(value)
+}
 
-	if dll.tail == nil {
-		dll.head = newNode
-		dll.tail = newNode
-	} else {
-		dll.tail.Next = newNode
-		newNode.Prev = dll.tail
-		dll.tail = newNode
+// PushFront adds an element to the beginning of the doubly linked list and returns its node.
+func (dll *DoublyLinkedList[T]) PushFront(value T) *DoublyNode[T] {
+	return dll.insertAfter(&DoublyNode[T]{Value: value}, nil)
+}
+
+// PushBack adds an element to the end of the doubly linked list and returns its node.
+func (dll *DoublyLinkedList[T]) PushBack(value T) *DoublyNode[T] {
+	return dll.insertAfter(&DoublyNode[T]{Value: value}, dll.tail)
+}
+
+// PushFrontList inserts a copy of the elements of other at the beginning of the list, keeping their order.
+// other may be the list itself.
+func (dll *DoublyLinkedList[T]) PushFrontList(other *DoublyLinkedList[T]) {
+	for i, current := other.size, other.tail; i > 0; i, current = i-1, current.Prev {
+		dll.PushFront(current.Value)
 	}
-	dll.size++
+}
+
+// PushBackList inserts a copy of the elements of other at the end of the list, keeping their order.
+// other may be the list itself.
+func (dll *DoublyLinkedList[T]) PushBackList(other *DoublyLinkedList[T]) {
+	for i, current := other.size, other.head; i > 0; i, current = i-1, current.Next {
+		dll.PushBack(current.Value)
+	}
+}
+
+// InsertBefore inserts an element immediately before mark and returns its node.
+// It panics if mark does not belong to the list.
+func (dll *DoublyLinkedList[T]) InsertBefore(value T, mark *DoublyNode[T]) *DoublyNode[T] {
+	dll.mustOwn(mark)
+	return dll.insertAfter(&DoublyNode[T]{Value: value}, mark.Prev)
+}
+
+// InsertAfter inserts an element immediately after mark and returns its node.
+// It panics if mark does not belong to the list.
+func (dll *DoublyLinkedList[T]) InsertAfter(value T, mark *DoublyNode[T]) *DoublyNode[T] {
+	dll.mustOwn(mark)
+	return dll.insertAfter(&DoublyNode[T]{Value: value}, mark)
+}
+
+// Front returns the first node of the list, or nil if the list is empty.
+func (dll *DoublyLinkedList[T]) Front() *DoublyNode[T] {
+	return dll.head
+}
+
+// Back returns the last node of the list, or nil if the list is empty.
+func (dll *DoublyLinkedList[T]) Back() *DoublyNode[T] {
+	return dll.tail
+}
+
+// Find returns the first node holding the specified value, or nil if it is not found.
+func (dll *DoublyLinkedList[T]) Find(value T) *DoublyNode[T] {
+	for current := dll.head; current != nil; current = current.Next {
+		if equalValues(dll.comparer, current.Value, value) {
+			return current
+		}
+	}
+	return nil
 }
 
 // Remove removes the first occurrence of the specified value from the doubly linked list.
 func (dll *DoublyLinkedList[T]) Remove(value T) bool {
-	if dll.head == nil {
+	node := dll.Find(value)
+	if node == nil {
 		return false
 	}
 
-	current := dll.head
-	for current != nil {
-		if current.Value == value {
-			if current.Prev != nil {
-				current.Prev.Next = current.Next
-			} else {
-				dll.head = current.Next
-			}
-
-			if current.Next != nil {
-				current.Next.Prev = current.Prev
-			} else {
-				dll.tail = current.Prev
-			}
-
-			dll.size--
-			return true
-		}
-		current = current.Next
+	dll.unlink(node)
+	return true
+}
+
+// RemoveNode removes node from the list in O(1) and returns its value.
+// It panics if node does not belong to the list.
+func (dll *DoublyLinkedList[T]) RemoveNode(node *DoublyNode[T]) T {
+	dll.mustOwn(node)
+	dll.unlink(node)
+	return node.Value
+}
+
+// RemoveFirst removes and returns the first element of the list.
+// It panics if the list is empty.
+func (dll *DoublyLinkedList[T]) RemoveFirst() T {
+	if dll.head == nil {
+		panic("RemoveFirst from an empty doubly linked list")
+	}
+
+	node := dll.head
+	dll.unlink(node)
+	return node.Value
+}
+
+// RemoveLast removes and returns the last element of the list.
+// It panics if the list is empty.
+func (dll *DoublyLinkedList[T]) RemoveLast() T {
+	if dll.tail == nil {
+		panic("RemoveLast from an empty doubly linked list")
 	}
 
-	return false
+	node := dll.tail
+	dll.unlink(node)
+	return node.Value
+}
+
+// MoveToFront moves node to the beginning of the list.
+// It panics if node does not belong to the list.
+func (dll *DoublyLinkedList[T]) MoveToFront(node *DoublyNode[T]) {
+	dll.mustOwn(node)
+	if node == dll.head {
+		return
+	}
+	dll.unlink(node)
+	dll.insertAfter(node, nil)
+}
+
+// MoveToBack moves node to the end of the list.
+// It panics if node does not belong to the list.
+func (dll *DoublyLinkedList[T]) MoveToBack(node *DoublyNode[T]) {
+	dll.mustOwn(node)
+	if node == dll.tail {
+		return
+	}
+	dll.unlink(node)
+	dll.insertAfter(node, dll.tail)
+}
+
+// MoveBefore moves node to the position immediately before mark.
+// It panics if either node does not belong to the list.
+func (dll *DoublyLinkedList[T]) MoveBefore(node, mark *DoublyNode[T]) {
+	dll.mustOwn(node)
+	dll.mustOwn(mark)
+	if node == mark || node.Next == mark {
+		return
+	}
+	dll.unlink(node)
+	dll.insertAfter(node, mark.Prev)
+}
+
+// MoveAfter moves node to the position immediately after mark.
+// It panics if either node does not belong to the list.
+func (dll *DoublyLinkedList[T]) MoveAfter(node, mark *DoublyNode[T]) {
+	dll.mustOwn(node)
+	dll.mustOwn(mark)
+	if node == mark || node.Prev == mark {
+		return
+	}
+	dll.unlink(node)
+	dll.insertAfter(node, mark)
 }
 
 // Contains checks if the doubly linked list contains the specified value.
 func (dll *DoublyLinkedList[T]) Contains(value T) bool {
-	current := dll.head
-	for current != nil {
-		if current.Value == value {
-			return true
-		}
-		current = current.Next
-	}
-	return false
+	return dll.Find(value) != nil
 }
 
 // Size returns the number of elements in the doubly linked list.
@@ -81,20 +193,39 @@ func (dll *DoublyLinkedList[T]) Size() int {
 	return dll.size
 }
 
-// Get retrieves the value at the specified index.
+// Get retrieves the value at the specified index, walking from whichever end is nearer.
 func (dll *DoublyLinkedList[T]) Get(index int) T {
 	if index < 0 || index >= dll.size {
 		panic("index out of bounds")
 	}
 
-	current := dll.head
-	for i := 0; i < index; i++ {
-		current = current.Next
+	if index < dll.size/2 {
+		current := dll.head
+		for i := 0; i < index; i++ {
+			current = current.Next
+		}
+		return current.Value
 	}
 
+	current := dll.tail
+	for i := dll.size - 1; i > index; i-- {
+		current = current.Prev
+	}
 	return current.Value
 }
 
+// Clear removes all elements from the doubly linked list.
+func (dll *DoublyLinkedList[T]) Clear() {
+	for current := dll.head; current != nil; {
+		next := current.Next
+		current.Prev, current.Next, current.list = nil, nil, nil
+		current = next
+	}
+	dll.head = nil
+	dll.tail = nil
+	dll.size = 0
+}
+
 // ToSlice converts the doubly linked list to a slice.
 func (dll *DoublyLinkedList[T]) ToSlice() []T {
 	slice := make([]T, 0, dll.size)
@@ -116,3 +247,47 @@ func (dll *DoublyLinkedList[T]) ReverseToSlice() []T {
 	}
 	return slice
 }
+
+func (dll *DoublyLinkedList[T]) mustOwn(node *DoublyNode[T]) {
+	if node == nil || node.list != dll {
+		panic("node does not belong to this list")
+	}
+}
+
+// insertAfter links node immediately after prev, or at the front if prev is nil.
+func (dll *DoublyLinkedList[T]) insertAfter(node, prev *DoublyNode[T]) *DoublyNode[T] {
+	node.list = dll
+	node.Prev = prev
+	if prev == nil {
+		node.Next = dll.head
+		dll.head = node
+	} else {
+		node.Next = prev.Next
+		prev.Next = node
+	}
+
+	if node.Next != nil {
+		node.Next.Prev = node
+	} else {
+		dll.tail = node
+	}
+	dll.size++
+	return node
+}
+
+func (dll *DoublyLinkedList[T]) unlink(node *DoublyNode[T]) {
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	} else {
+		dll.head = node.Next
+	}
+
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	} else {
+		dll.tail = node.Prev
+	}
+
+	node.Prev, node.Next, node.list = nil, nil, nil
+	dll.size--
+}
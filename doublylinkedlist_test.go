@@ -0,0 +1,104 @@
+package collections
+
+import "testing"
+
+func TestDoublyLinkedList(t *testing.T) {
+	dll := NewDoublyLinkedList[int]()
+	dll.Add(2)
+	two := dll.Back()
+	dll.PushFront(1)
+	four := dll.PushBack(4)
+	dll.InsertBefore(3, four)
+	dll.InsertAfter(5, four)
+
+	assertSliceEqual(t, dll.ToSlice(), []int{1, 2, 3, 4, 5})
+	assertSliceEqual(t, dll.ReverseToSlice(), []int{5, 4, 3, 2, 1})
+	for i := 0; i < dll.Size(); i++ {
+		if v := dll.Get(i); v != i+1 {
+			t.Errorf("Get(%d) = %d; want %d", i, v, i+1)
+		}
+	}
+
+	if node := dll.Find(3); node == nil || node.Value != 3 {
+		t.Errorf("Find(3) = %v; want node holding 3", node)
+	}
+	if node := dll.Find(9); node != nil {
+		t.Errorf("Find(9) = %v; want nil", node)
+	}
+
+	if v := dll.RemoveNode(two); v != 2 {
+		t.Errorf("RemoveNode() = %d; want 2", v)
+	}
+	if v := dll.RemoveFirst(); v != 1 {
+		t.Errorf("RemoveFirst() = %d; want 1", v)
+	}
+	if v := dll.RemoveLast(); v != 5 {
+		t.Errorf("RemoveLast() = %d; want 5", v)
+	}
+	assertSliceEqual(t, dll.ToSlice(), []int{3, 4})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a removed node, but did not panic")
+		}
+	}()
+	dll.RemoveNode(two)
+}
+
+func TestDoublyLinkedList_Move(t *testing.T) {
+	dll := NewDoublyLinkedList[string]()
+	a := dll.PushBack("a")
+	b := dll.PushBack("b")
+	c := dll.PushBack("c")
+	d := dll.PushBack("d")
+
+	dll.MoveToFront(c)
+	assertSliceEqual(t, dll.ToSlice(), []string{"c", "a", "b", "d"})
+	dll.MoveToBack(c)
+	assertSliceEqual(t, dll.ToSlice(), []string{"a", "b", "d", "c"})
+	dll.MoveBefore(d, a)
+	assertSliceEqual(t, dll.ToSlice(), []string{"d", "a", "b", "c"})
+	dll.MoveAfter(a, c)
+	assertSliceEqual(t, dll.ToSlice(), []string{"d", "b", "c", "a"})
+	dll.MoveAfter(b, b)
+	assertSliceEqual(t, dll.ToSlice(), []string{"d", "b", "c", "a"})
+	assertSliceEqual(t, dll.ReverseToSlice(), []string{"a", "c", "b", "d"})
+
+	if dll.Front() != d || dll.Back() != a {
+		t.Errorf("Front(), Back() = %v, %v; want d, a", dll.Front().Value, dll.Back().Value)
+	}
+
+	other := NewDoublyLinkedList[string]()
+	other.MoveToFront(other.PushBack("x"))
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a node from another list, but did not panic")
+		}
+	}()
+	dll.MoveToFront(other.Front())
+}
+
+func TestDoublyLinkedList_PushList(t *testing.T) {
+	dll := NewDoublyLinkedList[int]()
+	dll.Add(3)
+	dll.Add(4)
+
+	other := NewDoublyLinkedList[int]()
+	other.Add(1)
+	other.Add(2)
+
+	dll.PushFrontList(other)
+	assertSliceEqual(t, dll.ToSlice(), []int{1, 2, 3, 4})
+	dll.PushBackList(other)
+	assertSliceEqual(t, dll.ToSlice(), []int{1, 2, 3, 4, 1, 2})
+
+	other.PushBackList(other)
+	assertSliceEqual(t, other.ToSlice(), []int{1, 2, 1, 2})
+	other.PushFrontList(other)
+	assertSliceEqual(t, other.ToSlice(), []int{1, 2, 1, 2, 1, 2, 1, 2})
+
+	other.Clear()
+	if other.Size() != 0 || other.Front() != nil || other.Back() != nil {
+		t.Errorf("Clear() left %v", other.ToSlice())
+	}
+}
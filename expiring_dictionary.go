@@ -0,0 +1,192 @@
+package collections
+
+import "time"
+
+// ExpiringDictionary is a generic dictionary whose entries expire after a time-to-live.
+// Expired entries are removed lazily when they are read, or in bulk by RemoveExpired.
+// A TTL of zero or less means the entry never expires.
+type ExpiringDictionary[K comparable, V any] struct {
+	items      map[K]expiringEntry[V]
+	defaultTTL time.Duration
+	sliding    bool
+	now        func() time.Time
+	onExpired  func(key K, value V)
+}
+
+type expiringEntry[V any] struct {
+	value     V
+	ttl       time.Duration
+	expiresAt time.Time
+}
+
+// NewExpiringDictionary initializes a new empty ExpiringDictionary whose entries
+// expire after defaultTTL unless a TTL is given explicitly with SetWithTTL.
+//
+// Example:
+//  sessions := NewExpiringDictionary[string, int](30 * time.Minute)
+//  sessions.Set("alice", 42)
+func NewExpiringDictionary[K comparable, V any](defaultTTL time.Duration) *ExpiringDictionary[K, V] {
+	return &ExpiringDictionary[K, V]{
+		items:      make(map[K]expiringEntry[V]),
+		defaultTTL: defaultTTL,
+		now:        time.Now,
+	}
+}
+
+// SetClock replaces the function used to read the current time.
+// It is intended for deterministic tests.
+func (d *ExpiringDictionary[K, V]) SetClock(now func() time.Time) {
+	d.now = now
+}
+
+// SetSlidingExpiration enables or disables sliding expiration.
+// When enabled, every successful Get pushes the entry's expiry back by its TTL.
+func (d *ExpiringDictionary[K, V]) SetSlidingExpiration(enabled bool) {
+	d.sliding = enabled
+}
+
+// OnExpired registers a callback that is invoked for every entry removed because it expired.
+func (d *ExpiringDictionary[K, V]) OnExpired(callback func(key K, value V)) {
+	d.onExpired = callback
+}
+
+// Set adds or updates a key-value pair that expires after the default TTL.
+func (d *ExpiringDictionary[K, V]) Set(key K, value V) {
+	d.SetWithTTL(key, value, d.defaultTTL)
+}
+
+// SetWithTTL adds or updates a key-value pair that expires after ttl.
+func (d *ExpiringDictionary[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	entry := expiringEntry[V]{value: value, ttl: ttl}
+	if ttl > 0 {
+		entry.expiresAt = d.now().Add(ttl)
+	}
+	d.items[key] = entry
+}
+
+// Get retrieves the value for a given key from the ExpiringDictionary.
+// Returns the value and a boolean indicating if a live entry was found.
+func (d *ExpiringDictionary[K, V]) Get(key K) (V, bool) {
+	entry, ok := d.items[key]
+	if !ok {
+		var zeroValue V
+		return zeroValue, false
+	}
+
+	now := d.now()
+	if entry.expired(now) {
+		d.expire(key, entry)
+		var zeroValue V
+		return zeroValue, false
+	}
+
+	if d.sliding && entry.ttl > 0 {
+		entry.expiresAt = now.Add(entry.ttl)
+		d.items[key] = entry
+	}
+	return entry.value, true
+}
+
+// ContainsKey checks if the ExpiringDictionary holds a live entry for key.
+// Unlike Get, it does not refresh a sliding expiration.
+func (d *ExpiringDictionary[K, V]) ContainsKey(key K) bool {
+	entry, ok := d.items[key]
+	if !ok {
+		return false
+	}
+	if entry.expired(d.now()) {
+		d.expire(key, entry)
+		return false
+	}
+	return true
+}
+
+// TTL returns the time left before the entry for key expires.
+// The boolean is false if there is no live entry; a zero duration means the entry never expires.
+func (d *ExpiringDictionary[K, V]) TTL(key K) (time.Duration, bool) {
+	entry, ok := d.items[key]
+	if !ok {
+		return 0, false
+	}
+	now := d.now()
+	if entry.expired(now) {
+		d.expire(key, entry)
+		return 0, false
+	}
+	if entry.expiresAt.IsZero() {
+		return 0, true
+	}
+	return entry.expiresAt.Sub(now), true
+}
+
+// Remove removes a key-value pair from the ExpiringDictionary by key.
+// Returns true if a live entry was removed, false otherwise. An entry that has expired but not yet been
+// swept is dropped as expired, so Remove agrees with ContainsKey and returns false for it.
+// The expiry callback is not invoked for explicit removals of live entries.
+func (d *ExpiringDictionary[K, V]) Remove(key K) bool {
+	entry, ok := d.items[key]
+	if !ok {
+		return false
+	}
+	if entry.expired(d.now()) {
+		d.expire(key, entry)
+		return false
+	}
+	delete(d.items, key)
+	return true
+}
+
+// RemoveExpired removes every expired entry and returns how many were removed.
+func (d *ExpiringDictionary[K, V]) RemoveExpired() int {
+	now := d.now()
+	removed := 0
+	for key, entry := range d.items {
+		if entry.expired(now) {
+			d.expire(key, entry)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Keys returns a slice of all live keys in the ExpiringDictionary.
+func (d *ExpiringDictionary[K, V]) Keys() []K {
+	d.RemoveExpired()
+	keys := make([]K, 0, len(d.items))
+	for k := range d.items {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns a slice of all live values in the ExpiringDictionary.
+func (d *ExpiringDictionary[K, V]) Values() []V {
+	d.RemoveExpired()
+	values := make([]V, 0, len(d.items))
+	for _, entry := range d.items {
+		values = append(values, entry.value)
+	}
+	return values
+}
+
+// Count returns the number of live key-value pairs in the ExpiringDictionary.
+func (d *ExpiringDictionary[K, V]) Count() int {
+	d.RemoveExpired()
+	return len(d.items)
+}
+
+// Clear removes all key-value pairs from the ExpiringDictionary without invoking the expiry callback.
+func (d *ExpiringDictionary[K, V]) Clear() {
+	d.items = make(map[K]expiringEntry[V])
+}
+
+func (d *ExpiringDictionary[K, V]) expire(key K, entry expiringEntry[V]) {
+	delete(d.items, key)
+	if d.onExpired != nil {
+		d.onExpired(key, entry.value)
+	}
+}
+
+func (e expiringEntry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
@@ -0,0 +1,160 @@
+package collections
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestExpiringDictionary(t *testing.T) {
+	clock := newFakeClock()
+	dict := NewExpiringDictionary[string, int](time.Minute)
+	dict.SetClock(clock.Now)
+
+	dict.Set("a", 1)
+	dict.SetWithTTL("b", 2, 2*time.Minute)
+	dict.SetWithTTL("c", 3, 0)
+
+	if value, ok := dict.Get("a"); !ok || value != 1 {
+		t.Errorf("Get() = %v, %v; want 1, true", value, ok)
+	}
+	if ttl, ok := dict.TTL("b"); !ok || ttl != 2*time.Minute {
+		t.Errorf("TTL() = %v, %v; want 2m, true", ttl, ok)
+	}
+
+	clock.Advance(time.Minute)
+	if _, ok := dict.Get("a"); ok {
+		t.Errorf("Get() after expiry should be false")
+	}
+	if !dict.ContainsKey("b") {
+		t.Errorf("ContainsKey() = false; want true")
+	}
+
+	clock.Advance(time.Hour)
+	keys := dict.Keys()
+	if len(keys) != 1 || keys[0] != "c" {
+		t.Errorf("Keys() = %v; want [c]", keys)
+	}
+	if count := dict.Count(); count != 1 {
+		t.Errorf("Count() = %d; want 1", count)
+	}
+
+	if !dict.Remove("c") {
+		t.Errorf("Remove() = false; want true")
+	}
+	if dict.Remove("c") {
+		t.Errorf("Remove() = true; want false")
+	}
+}
+
+func TestExpiringDictionary_SlidingExpiration(t *testing.T) {
+	clock := newFakeClock()
+	dict := NewExpiringDictionary[string, int](time.Minute)
+	dict.SetClock(clock.Now)
+	dict.SetSlidingExpiration(true)
+
+	dict.Set("session", 1)
+	for i := 0; i < 5; i++ {
+		clock.Advance(50 * time.Second)
+		if _, ok := dict.Get("session"); !ok {
+			t.Fatalf("Get() after %d accesses should keep the entry alive", i+1)
+		}
+	}
+
+	// ContainsKey must not extend the lifetime.
+	clock.Advance(50 * time.Second)
+	dict.ContainsKey("session")
+	clock.Advance(10 * time.Second)
+	if _, ok := dict.Get("session"); ok {
+		t.Errorf("Get() after idle TTL should be false")
+	}
+}
+
+func TestExpiringDictionary_OnExpired(t *testing.T) {
+	clock := newFakeClock()
+	dict := NewExpiringDictionary[string, int](time.Minute)
+	dict.SetClock(clock.Now)
+
+	var expired []string
+	dict.OnExpired(func(key string, value int) {
+		expired = append(expired, key)
+	})
+
+	dict.Set("a", 1)
+	dict.Set("b", 2)
+	dict.SetWithTTL("c", 3, time.Hour)
+	dict.Set("d", 4)
+	dict.Remove("d")
+
+	clock.Advance(time.Minute)
+	if removed := dict.RemoveExpired(); removed != 2 {
+		t.Errorf("RemoveExpired() = %d; want 2", removed)
+	}
+
+	sort.Strings(expired)
+	if len(expired) != 2 || expired[0] != "a" || expired[1] != "b" {
+		t.Errorf("expired = %v; want [a b]", expired)
+	}
+}
+
+func TestExpiringSet(t *testing.T) {
+	clock := newFakeClock()
+	set := NewExpiringSet[string](time.Minute)
+	set.SetClock(clock.Now)
+
+	var expired []string
+	set.OnExpired(func(item string) {
+		expired = append(expired, item)
+	})
+
+	if !set.Add("x") {
+		t.Errorf("Add() = false; want true")
+	}
+	if set.Add("x") {
+		t.Errorf("Add() = true; want false")
+	}
+	set.AddWithTTL("y", 3*time.Minute)
+
+	clock.Advance(2 * time.Minute)
+	if set.Contains("x") {
+		t.Errorf("Contains() after expiry = true; want false")
+	}
+	if !set.Contains("y") {
+		t.Errorf("Contains() = false; want true")
+	}
+	if items := set.Items(); len(items) != 1 || items[0] != "y" {
+		t.Errorf("Items() = %v; want [y]", items)
+	}
+	if len(expired) != 1 || expired[0] != "x" {
+		t.Errorf("expired = %v; want [x]", expired)
+	}
+
+	clock.Advance(2 * time.Minute)
+	if set.Remove("y") {
+		t.Errorf("Remove() of an expired item = true; want false")
+	}
+	if len(expired) != 2 || expired[1] != "y" {
+		t.Errorf("expired = %v; want [x y]", expired)
+	}
+
+	set.Clear()
+	if count := set.Count(); count != 0 {
+		t.Errorf("Count() after Clear() = %d; want 0", count)
+	}
+}
@@ -0,0 +1,90 @@
+package collections
+
+import "time"
+
+// ExpiringSet is a generic set of unique items that expire after a time-to-live.
+// It shares its expiry semantics with ExpiringDictionary.
+type ExpiringSet[T comparable] struct {
+	items *ExpiringDictionary[T, struct{}]
+}
+
+// NewExpiringSet initializes a new empty ExpiringSet whose items expire after defaultTTL.
+func NewExpiringSet[T comparable](defaultTTL time.Duration) *ExpiringSet[T] {
+	return &ExpiringSet[T]{
+		items: NewExpiringDictionary[T, struct{}](defaultTTL),
+	}
+}
+
+// SetClock replaces the function used to read the current time.
+func (s *ExpiringSet[T]) SetClock(now func() time.Time) {
+	s.items.SetClock(now)
+}
+
+// SetSlidingExpiration enables or disables sliding expiration.
+// When enabled, every successful Contains pushes the item's expiry back by its TTL.
+func (s *ExpiringSet[T]) SetSlidingExpiration(enabled bool) {
+	s.items.SetSlidingExpiration(enabled)
+}
+
+// OnExpired registers a callback that is invoked for every item removed because it expired.
+func (s *ExpiringSet[T]) OnExpired(callback func(item T)) {
+	if callback == nil {
+		s.items.OnExpired(nil)
+		return
+	}
+	s.items.OnExpired(func(item T, _ struct{}) {
+		callback(item)
+	})
+}
+
+// Add adds an item that expires after the default TTL.
+// Returns true if the item was added, false if it was already present, in which case its expiry is reset.
+func (s *ExpiringSet[T]) Add(item T) bool {
+	return s.AddWithTTL(item, s.items.defaultTTL)
+}
+
+// AddWithTTL adds an item that expires after ttl.
+// Returns true if the item was added, false if it was already present, in which case its expiry is reset.
+func (s *ExpiringSet[T]) AddWithTTL(item T, ttl time.Duration) bool {
+	exists := s.items.ContainsKey(item)
+	s.items.SetWithTTL(item, struct{}{}, ttl)
+	return !exists
+}
+
+// Remove removes an item from the ExpiringSet.
+// Returns true if the item was removed, false if it was not present or had already expired.
+func (s *ExpiringSet[T]) Remove(item T) bool {
+	return s.items.Remove(item)
+}
+
+// Contains checks if a live item is present in the ExpiringSet.
+func (s *ExpiringSet[T]) Contains(item T) bool {
+	_, ok := s.items.Get(item)
+	return ok
+}
+
+// TTL returns the time left before item expires.
+// The boolean is false if the item is not present; a zero duration means the item never expires.
+func (s *ExpiringSet[T]) TTL(item T) (time.Duration, bool) {
+	return s.items.TTL(item)
+}
+
+// RemoveExpired removes every expired item and returns how many were removed.
+func (s *ExpiringSet[T]) RemoveExpired() int {
+	return s.items.RemoveExpired()
+}
+
+// Count returns the number of live items in the ExpiringSet.
+func (s *ExpiringSet[T]) Count() int {
+	return s.items.Count()
+}
+
+// Clear removes all items from the ExpiringSet.
+func (s *ExpiringSet[T]) Clear() {
+	s.items.Clear()
+}
+
+// Items returns a slice of all live items in the ExpiringSet.
+func (s *ExpiringSet[T]) Items() []T {
+	return s.items.Keys()
+}
@@ -0,0 +1,101 @@
+package collections
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// FenwickTree is a binary indexed tree over a fixed number of values that answers prefix and range sums
+// and applies point updates in O(log n).
+//
+// Example:
+//  sales := NewFenwickTreeFrom([]int{5, 3, 7, 2})
+//  sales.Add(1, 10)
+//  fmt.Println(sales.PrefixSum(2))   // Output: 18
+//  fmt.Println(sales.RangeSum(1, 3)) // Output: 20
+type FenwickTree[T Number] struct {
+	// tree[i] holds the sum of the values in (i - i&-i, i], using one-based positions.
+	tree []T
+}
+
+// NewFenwickTree creates a new FenwickTree of n zero values.
+func NewFenwickTree[T Number](n int) *FenwickTree[T] {
+	return &FenwickTree[T]{tree: make([]T, n+1)}
+}
+
+// NewFenwickTreeFrom creates a FenwickTree holding the values in items.
+// The tree is built in O(n).
+func NewFenwickTreeFrom[T Number](items []T) *FenwickTree[T] {
+	f := &FenwickTree[T]{tree: make([]T, len(items)+1)}
+	copy(f.tree[1:], items)
+	for i := 1; i < len(f.tree); i++ {
+		if parent := i + i&-i; parent < len(f.tree) {
+			f.tree[parent] += f.tree[i]
+		}
+	}
+	return f
+}
+
+// Count returns the number of values in the tree.
+func (f *FenwickTree[T]) Count() int {
+	return len(f.tree) - 1
+}
+
+// Add adds delta to the value at index in O(log n).
+// It panics if index is out of range.
+func (f *FenwickTree[T]) Add(index int, delta T) {
+	if index < 0 || index >= f.Count() {
+		panic("Index out of range.")
+	}
+	for i := index + 1; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+}
+
+// Set replaces the value at index in O(log n).
+// It panics if index is out of range.
+func (f *FenwickTree[T]) Set(index int, value T) {
+	f.Add(index, value-f.Get(index))
+}
+
+// Get returns the value at index in O(log n).
+// It panics if index is out of range.
+func (f *FenwickTree[T]) Get(index int) T {
+	if index < 0 || index >= f.Count() {
+		panic("Index out of range.")
+	}
+	return f.RangeSum(index, index+1)
+}
+
+// PrefixSum returns the sum of the first n values in O(log n).
+// It panics if n is negative or greater than Count.
+func (f *FenwickTree[T]) PrefixSum(n int) T {
+	if n < 0 || n > f.Count() {
+		panic("Index out of range.")
+	}
+	var sum T
+	for i := n; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the values in the half-open index range [lo, hi) in O(log n).
+// It panics if the range is out of bounds or lo is greater than hi.
+func (f *FenwickTree[T]) RangeSum(lo, hi int) T {
+	if lo > hi {
+		panic("Index out of range.")
+	}
+	return f.PrefixSum(hi) - f.PrefixSum(lo)
+}
+
+// Items returns a slice of all values in index order.
+func (f *FenwickTree[T]) Items() []T {
+	items := make([]T, f.Count())
+	for i := range items {
+		items[i] = f.Get(i)
+	}
+	return items
+}
@@ -0,0 +1,64 @@
+package collections
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestFenwickTree(t *testing.T) {
+	tree := NewFenwickTreeFrom([]int{5, 3, 7, 2, 8})
+	if sum := tree.PrefixSum(3); sum != 15 {
+		t.Errorf("PrefixSum(3) = %d; want 15", sum)
+	}
+	if sum := tree.PrefixSum(0); sum != 0 {
+		t.Errorf("PrefixSum(0) = %d; want 0", sum)
+	}
+	tree.Add(1, 10)
+	tree.Set(4, 1)
+	if sum := tree.RangeSum(1, 4); sum != 22 {
+		t.Errorf("RangeSum(1, 4) = %d; want 22", sum)
+	}
+	assertSliceEqual(t, tree.Items(), []int{5, 13, 7, 2, 1})
+
+	empty := NewFenwickTree[float64](3)
+	empty.Add(2, 1.5)
+	if sum := empty.PrefixSum(3); sum != 1.5 || empty.Count() != 3 {
+		t.Errorf("PrefixSum(3) = %v, Count() = %d; want 1.5, 3", sum, empty.Count())
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for an out of range Add, but did not panic")
+		}
+	}()
+	tree.Add(5, 1)
+}
+
+func TestFenwickTree_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	reference := make([]int64, 300)
+	for i := range reference {
+		reference[i] = r.Int63n(100)
+	}
+	tree := NewFenwickTreeFrom(reference)
+
+	for i := 0; i < 2000; i++ {
+		index := r.Intn(len(reference))
+		if r.Intn(2) == 0 {
+			delta := r.Int63n(200) - 100
+			tree.Add(index, delta)
+			reference[index] += delta
+		} else {
+			lo := r.Intn(len(reference) + 1)
+			hi := lo + r.Intn(len(reference)+1-lo)
+			var want int64
+			for _, v := range reference[lo:hi] {
+				want += v
+			}
+			if got := tree.RangeSum(lo, hi); got != want {
+				t.Fatalf("RangeSum(%d, %d) = %d; want %d", lo, hi, got, want)
+			}
+		}
+	}
+	assertSliceEqual(t, tree.Items(), reference)
+}
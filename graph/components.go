@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// ConnectedComponents returns the vertices of each connected component, ignoring edge direction.
+// Components are ordered by their earliest added vertex, and each lists its vertices in breadth-first order.
+func (g *Graph[V]) ConnectedComponents() [][]V {
+	undirected := g
+	if g.directed {
+		undirected = NewUndirected[V]()
+		for _, v := range g.vertices {
+			undirected.AddVertex(v)
+		}
+		for _, e := range g.Edges() {
+			undirected.AddEdge(e.From, e.To)
+		}
+	}
+
+	var components [][]V
+	seen := collections.NewHashSet[V]()
+	for _, v := range undirected.vertices {
+		if seen.Contains(v) {
+			continue
+		}
+		var component []V
+		for it := undirected.BFS(v); it.HasNext(); {
+			u, _ := it.Next()
+			seen.Add(u)
+			component = append(component, u)
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// StronglyConnectedComponents returns the strongly connected components of the graph, found with Tarjan's algorithm:
+// the maximal sets of vertices in which every vertex can reach every other. In an undirected graph
+// these are the connected components.
+// A component is listed before every component that has an edge into it, so the result is
+// a reverse topological order of the components. Each component lists its vertices in the order they were added.
+func (g *Graph[V]) StronglyConnectedComponents() [][]V {
+	position := make(map[V]int, len(g.vertices))
+	for i, v := range g.vertices {
+		position[v] = i
+	}
+
+	// index is the order in which vertices are discovered, and low the smallest index reachable
+	// through the vertex's subtree and at most one edge back to a vertex still on the stack.
+	index := make(map[V]int, len(g.vertices))
+	low := make(map[V]int, len(g.vertices))
+	onStack := collections.NewHashSet[V]()
+	stack := collections.NewStack[V]()
+	var components [][]V
+
+	var connect func(v V)
+	connect = func(v V) {
+		index[v] = len(index)
+		low[v] = index[v]
+		stack.Push(v)
+		onStack.Add(v)
+
+		for _, to := range g.adjacency[v].neighbors {
+			if _, seen := index[to]; !seen {
+				connect(to)
+				low[v] = min(low[v], low[to])
+			} else if onStack.Contains(to) {
+				low[v] = min(low[v], index[to])
+			}
+		}
+
+		if low[v] == index[v] {
+			var component []V
+			for {
+				u := stack.Pop()
+				onStack.Remove(u)
+				component = append(component, u)
+				if u == v {
+					break
+				}
+			}
+			sortByPosition(component, position)
+			components = append(components, component)
+		}
+	}
+
+	for _, v := range g.vertices {
+		if _, seen := index[v]; !seen {
+			connect(v)
+		}
+	}
+	return components
+}
+
+// sortByPosition sorts vertices by their position in the graph's insertion order.
+func sortByPosition[V comparable](vertices []V, position map[V]int) {
+	sort.Slice(vertices, func(i, j int) bool { return position[vertices[i]] < position[vertices[j]] })
+}
@@ -0,0 +1,28 @@
+package graph
+
+import "testing"
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := NewDirected[int]()
+	// Components {1, 2, 3} -> {4, 5} -> {6}, plus the lone vertex 7.
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 4)
+	g.AddEdge(5, 6)
+	g.AddVertex(7)
+
+	assertEqual(t, g.StronglyConnectedComponents(), [][]int{{6}, {4, 5}, {1, 2, 3}, {7}})
+	assertEqual(t, g.ConnectedComponents(), [][]int{{1, 2, 3, 4, 5, 6}, {7}})
+}
+
+func TestConnectedComponents_Undirected(t *testing.T) {
+	g := NewUndirected[string]()
+	g.AddEdge("a", "b")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	assertEqual(t, g.ConnectedComponents(), [][]string{{"a", "b"}, {"c", "d", "e"}})
+	assertEqual(t, g.StronglyConnectedComponents(), [][]string{{"a", "b"}, {"c", "d", "e"}})
+}
@@ -0,0 +1,126 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// CycleError is returned when an operation that needs an acyclic graph finds a cycle.
+type CycleError[V comparable] struct {
+	// Cycle lists the vertices of the cycle; each has an edge to the next, and the last has an edge to the first.
+	Cycle []V
+}
+
+func (e *CycleError[V]) Error() string {
+	return formatCycle("graph: cycle ", e.Cycle)
+}
+
+// formatCycle writes a cycle as "a -> b -> c -> a" after prefix.
+func formatCycle[V comparable](prefix string, cycle []V) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	for _, v := range cycle {
+		fmt.Fprintf(&b, "%v -> ", v)
+	}
+	if len(cycle) > 0 {
+		fmt.Fprintf(&b, "%v", cycle[0])
+	}
+	return b.String()
+}
+
+// HasCycle checks if the graph contains a cycle. A self-loop is a cycle; in an undirected graph
+// an edge on its own is not.
+func (g *Graph[V]) HasCycle() bool {
+	_, ok := g.FindCycle()
+	return ok
+}
+
+// FindCycle returns the vertices of a cycle in the graph, in which each vertex has an edge to the next
+// and the last has an edge to the first.
+// The boolean result is false if the graph is acyclic.
+func (g *Graph[V]) FindCycle() ([]V, bool) {
+	const (
+		unvisited = iota
+		active    // on the current depth-first path
+		done
+	)
+	state := make(map[V]int, len(g.vertices))
+	var path []V
+
+	var visit func(v V, parent V, hasParent bool) []V
+	visit = func(v V, parent V, hasParent bool) []V {
+		state[v] = active
+		path = append(path, v)
+		for _, to := range g.adjacency[v].neighbors {
+			switch state[to] {
+			case active:
+				// In an undirected graph the edge back to the parent is the edge just followed.
+				if !g.directed && hasParent && to == parent && to != v {
+					continue
+				}
+				for i := len(path) - 1; i >= 0; i-- {
+					if path[i] == to {
+						return append([]V(nil), path[i:]...)
+					}
+				}
+			case unvisited:
+				if cycle := visit(to, v, true); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[v] = done
+		return nil
+	}
+
+	for _, v := range g.vertices {
+		if state[v] == unvisited {
+			var zeroValue V
+			if cycle := visit(v, zeroValue, false); cycle != nil {
+				return cycle, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// TopologicalSort returns the vertices of a directed graph ordered so that every edge goes from
+// an earlier vertex to a later one. The order is deterministic: vertices without incoming edges come first
+// in the order they were added, and the rest follow as their last incoming edge is satisfied.
+// It returns a *CycleError if the graph has a cycle, and panics if the graph is undirected.
+func (g *Graph[V]) TopologicalSort() ([]V, error) {
+	if !g.directed {
+		panic("Topological sort requires a directed graph.")
+	}
+
+	// Kahn's algorithm: repeatedly take a vertex whose remaining in-degree is zero.
+	remaining := make(map[V]int, len(g.vertices))
+	ready := collections.NewQueue[V]()
+	for _, v := range g.vertices {
+		remaining[v] = g.adjacency[v].inDegree
+		if remaining[v] == 0 {
+			ready.Enqueue(v)
+		}
+	}
+
+	order := make([]V, 0, len(g.vertices))
+	for !ready.IsEmpty() {
+		v := ready.Dequeue()
+		order = append(order, v)
+		for _, to := range g.adjacency[v].neighbors {
+			remaining[to]--
+			if remaining[to] == 0 {
+				ready.Enqueue(to)
+			}
+		}
+	}
+
+	if len(order) < len(g.vertices) {
+		cycle, _ := g.FindCycle()
+		return nil, &CycleError[V]{Cycle: cycle}
+	}
+	return order, nil
+}
@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	g := NewDirected[string]()
+	g.AddVertex("lint")
+	g.AddEdge("fetch", "build")
+	g.AddEdge("build", "test")
+	g.AddEdge("fetch", "test")
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatalf("TopologicalSort() error = %v", err)
+	}
+	assertEqual(t, order, []string{"lint", "fetch", "build", "test"})
+	if g.HasCycle() {
+		t.Error("HasCycle() = true on a DAG")
+	}
+
+	g.AddEdge("test", "fetch")
+	_, err = g.TopologicalSort()
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("TopologicalSort() error = %v; want a *CycleError", err)
+	}
+	assertEqual(t, cycleErr.Cycle, []string{"fetch", "build", "test"})
+	if msg := err.Error(); msg != "graph: cycle fetch -> build -> test -> fetch" {
+		t.Errorf("Error() = %q", msg)
+	}
+}
+
+func TestFindCycle_Undirected(t *testing.T) {
+	g := NewUndirected[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	if g.HasCycle() {
+		t.Error("HasCycle() = true on a tree")
+	}
+	g.AddEdge(4, 2)
+	cycle, ok := g.FindCycle()
+	if !ok {
+		t.Fatal("FindCycle() = false; want true")
+	}
+	assertEqual(t, cycle, []int{2, 3, 4})
+
+	loop := NewDirected[int]()
+	loop.AddEdge(7, 7)
+	if cycle, _ := loop.FindCycle(); len(cycle) != 1 || cycle[0] != 7 {
+		t.Errorf("FindCycle() = %v; want [7]", cycle)
+	}
+}
+
+func TestTopologicalSort_PanicsOnUndirected(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for an undirected graph, but did not panic")
+		}
+	}()
+	NewUndirected[int]().TopologicalSort()
+}
@@ -0,0 +1,305 @@
+package graph
+
+import "github.com/VikashChauhan51/collections"
+
+// FlowEdge is an edge of a FlowNetwork with its capacity and the flow it carries.
+type FlowEdge[V comparable] struct {
+	From, To V
+	Capacity int64
+	Flow     int64
+}
+
+// FlowNetwork is a directed graph whose edges have integer capacities, for computing maximum flows and minimum cuts.
+// Parallel edges are allowed. Vertices and edges are kept in the order they were added,
+// so every algorithm gives the same result on every run.
+//
+// Example:
+//  net := graph.NewFlowNetwork[string]()
+//  net.AddEdge("s", "a", 3)
+//  net.AddEdge("s", "b", 2)
+//  net.AddEdge("a", "t", 2)
+//  net.AddEdge("b", "t", 3)
+//  fmt.Println(net.Dinic("s", "t").Value()) // Output: 4
+type FlowNetwork[V comparable] struct {
+	index    map[V]int
+	vertices []V
+	// Edges are stored in pairs: edge e runs forward with its capacity, and edge e^1 is its residual reverse.
+	heads     []int
+	capacity  []int64
+	adjacency [][]int
+}
+
+// NewFlowNetwork creates a new empty FlowNetwork.
+func NewFlowNetwork[V comparable]() *FlowNetwork[V] {
+	return &FlowNetwork[V]{index: make(map[V]int)}
+}
+
+// AddVertex adds a vertex with no edges.
+// Returns true if the vertex was added, false if it was already present.
+func (n *FlowNetwork[V]) AddVertex(v V) bool {
+	if _, ok := n.index[v]; ok {
+		return false
+	}
+	n.vertexIndex(v)
+	return true
+}
+
+func (n *FlowNetwork[V]) vertexIndex(v V) int {
+	if i, ok := n.index[v]; ok {
+		return i
+	}
+	i := len(n.vertices)
+	n.index[v] = i
+	n.vertices = append(n.vertices, v)
+	n.adjacency = append(n.adjacency, nil)
+	return i
+}
+
+// HasVertex checks if a vertex is present.
+func (n *FlowNetwork[V]) HasVertex(v V) bool {
+	_, ok := n.index[v]
+	return ok
+}
+
+// AddEdge adds an edge with a capacity from one vertex to another, adding either vertex if it is not present.
+// It panics if capacity is negative.
+func (n *FlowNetwork[V]) AddEdge(from, to V, capacity int64) {
+	if capacity < 0 {
+		panic("Capacity must not be negative.")
+	}
+	u, v := n.vertexIndex(from), n.vertexIndex(to)
+	e := len(n.heads)
+	n.heads = append(n.heads, v, u)
+	n.capacity = append(n.capacity, capacity, 0)
+	n.adjacency[u] = append(n.adjacency[u], e)
+	n.adjacency[v] = append(n.adjacency[v], e+1)
+}
+
+// Capacity returns the total capacity of the edges from one vertex to another.
+func (n *FlowNetwork[V]) Capacity(from, to V) int64 {
+	u, ok := n.index[from]
+	v, ok2 := n.index[to]
+	if !ok || !ok2 {
+		return 0
+	}
+	var total int64
+	for _, e := range n.adjacency[u] {
+		if e%2 == 0 && n.heads[e] == v {
+			total += n.capacity[e]
+		}
+	}
+	return total
+}
+
+// Vertices returns a slice of all vertices in the order they were added.
+func (n *FlowNetwork[V]) Vertices() []V {
+	vertices := make([]V, len(n.vertices))
+	copy(vertices, n.vertices)
+	return vertices
+}
+
+// VertexCount returns the number of vertices.
+func (n *FlowNetwork[V]) VertexCount() int {
+	return len(n.vertices)
+}
+
+// EdgeCount returns the number of edges.
+func (n *FlowNetwork[V]) EdgeCount() int {
+	return len(n.heads) / 2
+}
+
+func (n *FlowNetwork[V]) endpoints(source, sink V) (int, int) {
+	s, ok := n.index[source]
+	t, ok2 := n.index[sink]
+	if !ok || !ok2 {
+		panic("Source and sink must be vertices of the network.")
+	}
+	if s == t {
+		panic("Source and sink must differ.")
+	}
+	return s, t
+}
+
+// EdmondsKarp finds a maximum flow from source to sink in O(V * E^2) by repeatedly augmenting
+// along a shortest path in the residual network.
+// It panics if source or sink is not present, or if they are the same vertex.
+func (n *FlowNetwork[V]) EdmondsKarp(source, sink V) *MaxFlow[V] {
+	s, t := n.endpoints(source, sink)
+	f := &MaxFlow[V]{network: n, source: s, flow: make([]int64, len(n.heads))}
+
+	parentEdge := make([]int, len(n.vertices))
+	for {
+		for i := range parentEdge {
+			parentEdge[i] = -1
+		}
+		queue := collections.NewQueue[int]()
+		queue.Enqueue(s)
+		for !queue.IsEmpty() && parentEdge[t] < 0 {
+			u := queue.Dequeue()
+			for _, e := range n.adjacency[u] {
+				v := n.heads[e]
+				if v != s && parentEdge[v] < 0 && f.residual(e) > 0 {
+					parentEdge[v] = e
+					queue.Enqueue(v)
+				}
+			}
+		}
+		if parentEdge[t] < 0 {
+			return f
+		}
+
+		bottleneck := int64(-1)
+		for v := t; v != s; v = n.heads[parentEdge[v]^1] {
+			if r := f.residual(parentEdge[v]); bottleneck < 0 || r < bottleneck {
+				bottleneck = r
+			}
+		}
+		for v := t; v != s; v = n.heads[parentEdge[v]^1] {
+			f.push(parentEdge[v], bottleneck)
+		}
+		f.value += bottleneck
+	}
+}
+
+// Dinic finds a maximum flow from source to sink in O(V^2 * E), and much faster in practice,
+// by augmenting along all shortest residual paths at once in each phase.
+// It panics if source or sink is not present, or if they are the same vertex.
+func (n *FlowNetwork[V]) Dinic(source, sink V) *MaxFlow[V] {
+	s, t := n.endpoints(source, sink)
+	f := &MaxFlow[V]{network: n, source: s, flow: make([]int64, len(n.heads))}
+	level := make([]int, len(n.vertices))
+	// next[u] is the position in u's adjacency of the first edge that may still carry more flow this phase.
+	next := make([]int, len(n.vertices))
+
+	var augment func(u int, limit int64) int64
+	augment = func(u int, limit int64) int64 {
+		if u == t {
+			return limit
+		}
+		for ; next[u] < len(n.adjacency[u]); next[u]++ {
+			e := n.adjacency[u][next[u]]
+			v := n.heads[e]
+			if level[v] != level[u]+1 || f.residual(e) <= 0 {
+				continue
+			}
+			if pushed := augment(v, min(limit, f.residual(e))); pushed > 0 {
+				f.push(e, pushed)
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	for f.levels(level, t) {
+		for i := range next {
+			next[i] = 0
+		}
+		for {
+			pushed := augment(s, int64(1<<63-1))
+			if pushed == 0 {
+				break
+			}
+			f.value += pushed
+		}
+	}
+	return f
+}
+
+// MaxFlow is a maximum flow through a FlowNetwork. It is invalid once edges are added to the network.
+type MaxFlow[V comparable] struct {
+	network *FlowNetwork[V]
+	source  int
+	// flow[e] is the flow on edge e; the flow on a reverse edge is the negated flow on its forward edge.
+	flow  []int64
+	value int64
+}
+
+func (f *MaxFlow[V]) residual(e int) int64 {
+	return f.network.capacity[e] - f.flow[e]
+}
+
+func (f *MaxFlow[V]) push(e int, amount int64) {
+	f.flow[e] += amount
+	f.flow[e^1] -= amount
+}
+
+// levels labels every vertex with its distance from the source in the residual network,
+// or -1 if it is unreachable, and reports whether t is reachable.
+func (f *MaxFlow[V]) levels(level []int, t int) bool {
+	for i := range level {
+		level[i] = -1
+	}
+	level[f.source] = 0
+	queue := collections.NewQueue[int]()
+	queue.Enqueue(f.source)
+	for !queue.IsEmpty() {
+		u := queue.Dequeue()
+		for _, e := range f.network.adjacency[u] {
+			if v := f.network.heads[e]; level[v] < 0 && f.residual(e) > 0 {
+				level[v] = level[u] + 1
+				queue.Enqueue(v)
+			}
+		}
+	}
+	return level[t] >= 0
+}
+
+// Value returns the total flow from the source to the sink.
+func (f *MaxFlow[V]) Value() int64 {
+	return f.value
+}
+
+// Flow returns the total flow on the edges from one vertex to another.
+func (f *MaxFlow[V]) Flow(from, to V) int64 {
+	u, ok := f.network.index[from]
+	v, ok2 := f.network.index[to]
+	if !ok || !ok2 {
+		return 0
+	}
+	var total int64
+	for _, e := range f.network.adjacency[u] {
+		if e%2 == 0 && f.network.heads[e] == v {
+			total += f.flow[e]
+		}
+	}
+	return total
+}
+
+// Edges returns the edges that carry flow, in the order they were added to the network.
+func (f *MaxFlow[V]) Edges() []FlowEdge[V] {
+	var edges []FlowEdge[V]
+	for e := 0; e < len(f.flow); e += 2 {
+		if f.flow[e] > 0 {
+			edges = append(edges, f.edge(e))
+		}
+	}
+	return edges
+}
+
+func (f *MaxFlow[V]) edge(e int) FlowEdge[V] {
+	n := f.network
+	return FlowEdge[V]{From: n.vertices[n.heads[e^1]], To: n.vertices[n.heads[e]], Capacity: n.capacity[e], Flow: f.flow[e]}
+}
+
+// MinCut returns a minimum cut separating the source from the sink: the vertices on the source side,
+// which are those still reachable from the source in the residual network, and the edges crossing
+// from the source side to the sink side, whose capacities add up to Value.
+// Both are in the order they were added to the network.
+func (f *MaxFlow[V]) MinCut() ([]V, []FlowEdge[V]) {
+	level := make([]int, len(f.network.vertices))
+	f.levels(level, f.source)
+
+	var sourceSide []V
+	var cut []FlowEdge[V]
+	for u, v := range f.network.vertices {
+		if level[u] >= 0 {
+			sourceSide = append(sourceSide, v)
+		}
+	}
+	for e := 0; e < len(f.flow); e += 2 {
+		if level[f.network.heads[e^1]] >= 0 && level[f.network.heads[e]] < 0 {
+			cut = append(cut, f.edge(e))
+		}
+	}
+	return sourceSide, cut
+}
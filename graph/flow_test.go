@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// pipes returns the classic network from CLRS with a maximum flow of 23.
+func pipes() *FlowNetwork[string] {
+	n := NewFlowNetwork[string]()
+	n.AddEdge("s", "v1", 16)
+	n.AddEdge("s", "v2", 13)
+	n.AddEdge("v2", "v1", 4)
+	n.AddEdge("v1", "v3", 12)
+	n.AddEdge("v3", "v2", 9)
+	n.AddEdge("v2", "v4", 14)
+	n.AddEdge("v4", "v3", 7)
+	n.AddEdge("v3", "t", 20)
+	n.AddEdge("v4", "t", 4)
+	return n
+}
+
+func TestMaxFlow(t *testing.T) {
+	n := pipes()
+	for name, flow := range map[string]*MaxFlow[string]{"EdmondsKarp": n.EdmondsKarp("s", "t"), "Dinic": n.Dinic("s", "t")} {
+		if flow.Value() != 23 {
+			t.Errorf("%s Value() = %d; want 23", name, flow.Value())
+		}
+		if got := flow.Flow("v3", "t") + flow.Flow("v4", "t"); got != 23 {
+			t.Errorf("%s flow into t = %d; want 23", name, got)
+		}
+		sourceSide, cut := flow.MinCut()
+		assertEqual(t, sourceSide, []string{"s", "v1", "v2", "v4"})
+		assertEqual(t, cut, []FlowEdge[string]{{"v1", "v3", 12, 12}, {"v4", "v3", 7, 7}, {"v4", "t", 4, 4}})
+	}
+	if n.Capacity("s", "v1") != 16 || n.VertexCount() != 6 || n.EdgeCount() != 9 {
+		t.Error("network queries returned an unexpected result")
+	}
+}
+
+func TestMaxFlow_ParallelEdgesAndUnreachableSink(t *testing.T) {
+	n := NewFlowNetwork[int]()
+	n.AddEdge(1, 2, 3)
+	n.AddEdge(1, 2, 4)
+	n.AddVertex(3)
+	if v := n.Dinic(1, 2).Value(); v != 7 {
+		t.Errorf("Dinic(1, 2).Value() = %d; want 7", v)
+	}
+	flow := n.EdmondsKarp(1, 3)
+	if flow.Value() != 0 || flow.Edges() != nil {
+		t.Errorf("EdmondsKarp(1, 3) = %d with edges %v; want no flow", flow.Value(), flow.Edges())
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for equal source and sink, but did not panic")
+		}
+	}()
+	n.Dinic(1, 1)
+}
+
+func TestMaxFlow_AgreeOnRandomNetworks(t *testing.T) {
+	r := rand.New(rand.NewSource(31))
+	for round := 0; round < 30; round++ {
+		n := NewFlowNetwork[int]()
+		for i := 0; i < 12; i++ {
+			n.AddVertex(i)
+		}
+		for i := 0; i < 40; i++ {
+			n.AddEdge(r.Intn(12), r.Intn(12), r.Int63n(20))
+		}
+
+		edmondsKarp, dinic := n.EdmondsKarp(0, 11), n.Dinic(0, 11)
+		if edmondsKarp.Value() != dinic.Value() {
+			t.Fatalf("EdmondsKarp gave %d, Dinic %d", edmondsKarp.Value(), dinic.Value())
+		}
+		for _, flow := range []*MaxFlow[int]{edmondsKarp, dinic} {
+			// Flow is conserved at every vertex other than the source and sink, and respects capacities.
+			balance := make(map[int]int64)
+			for _, e := range flow.Edges() {
+				if e.Flow > e.Capacity {
+					t.Fatalf("edge %v carries more than its capacity", e)
+				}
+				balance[e.From] -= e.Flow
+				balance[e.To] += e.Flow
+			}
+			for v, b := range balance {
+				if v != 0 && v != 11 && b != 0 {
+					t.Fatalf("flow is not conserved at %d", v)
+				}
+			}
+			var cutCapacity int64
+			_, cut := flow.MinCut()
+			for _, e := range cut {
+				cutCapacity += e.Capacity
+			}
+			if cutCapacity != flow.Value() {
+				t.Fatalf("min cut capacity %d differs from flow %d", cutCapacity, flow.Value())
+			}
+		}
+	}
+}
@@ -0,0 +1,275 @@
+// Package graph provides directed and undirected adjacency-list graphs over comparable vertex types,
+// with traversals and graph algorithms built on the collections package.
+package graph
+
+// Edge is an edge from From to To with a weight. Unweighted edges have weight 1.
+type Edge[V comparable] struct {
+	From, To V
+	Weight   float64
+}
+
+// Graph is a directed or undirected graph stored as adjacency lists, with at most one edge
+// from one vertex to another. Vertices, and the neighbors of each vertex, are kept in the order
+// they were added, so traversals and algorithms give the same result on every run.
+//
+// Example:
+//  g := graph.NewDirected[string]()
+//  g.AddEdge("fetch", "build")
+//  g.AddEdge("build", "test")
+//  order, _ := g.TopologicalSort()
+//  fmt.Println(order) // Output: [fetch build test]
+type Graph[V comparable] struct {
+	directed  bool
+	vertices  []V
+	adjacency map[V]*adjacency[V]
+	edgeCount int
+}
+
+// adjacency holds the outgoing edges of a vertex.
+type adjacency[V comparable] struct {
+	neighbors []V
+	weights   map[V]float64
+	inDegree  int
+}
+
+// NewDirected creates a new empty directed graph.
+func NewDirected[V comparable]() *Graph[V] {
+	return &Graph[V]{directed: true, adjacency: make(map[V]*adjacency[V])}
+}
+
+// NewUndirected creates a new empty undirected graph.
+func NewUndirected[V comparable]() *Graph[V] {
+	return &Graph[V]{adjacency: make(map[V]*adjacency[V])}
+}
+
+// IsDirected checks if the graph is directed.
+func (g *Graph[V]) IsDirected() bool {
+	return g.directed
+}
+
+// AddVertex adds a vertex with no edges.
+// Returns true if the vertex was added, false if it was already present.
+func (g *Graph[V]) AddVertex(v V) bool {
+	if _, ok := g.adjacency[v]; ok {
+		return false
+	}
+	g.vertices = append(g.vertices, v)
+	g.adjacency[v] = &adjacency[V]{weights: make(map[V]float64)}
+	return true
+}
+
+// RemoveVertex removes a vertex and every edge touching it in O(V + E).
+// Returns true if the vertex was removed, false if it was not present.
+func (g *Graph[V]) RemoveVertex(v V) bool {
+	adj, ok := g.adjacency[v]
+	if !ok {
+		return false
+	}
+	for _, to := range append([]V(nil), adj.neighbors...) {
+		g.RemoveEdge(v, to)
+	}
+	if g.directed {
+		for _, from := range g.vertices {
+			g.RemoveEdge(from, v)
+		}
+	}
+
+	delete(g.adjacency, v)
+	for i, u := range g.vertices {
+		if u == v {
+			g.vertices = append(g.vertices[:i], g.vertices[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// HasVertex checks if a vertex is present.
+func (g *Graph[V]) HasVertex(v V) bool {
+	_, ok := g.adjacency[v]
+	return ok
+}
+
+// AddEdge adds an edge of weight 1 from one vertex to another, adding either vertex if it is not present.
+// In an undirected graph the edge connects the vertices both ways.
+// Returns true if the edge was added, false if it was already present, in which case its weight is unchanged.
+func (g *Graph[V]) AddEdge(from, to V) bool {
+	if g.HasEdge(from, to) {
+		return false
+	}
+	return g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge with a weight from one vertex to another, adding either vertex if it is not present.
+// If the edge is already present its weight is replaced.
+// In an undirected graph the edge connects the vertices both ways.
+// Returns true if the edge was added, false if it was already present.
+func (g *Graph[V]) AddWeightedEdge(from, to V, weight float64) bool {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	added := g.link(from, to, weight)
+	if !g.directed && from != to {
+		g.link(to, from, weight)
+	}
+	if added {
+		g.edgeCount++
+	}
+	return added
+}
+
+func (g *Graph[V]) link(from, to V, weight float64) bool {
+	adj := g.adjacency[from]
+	_, exists := adj.weights[to]
+	adj.weights[to] = weight
+	if exists {
+		return false
+	}
+	adj.neighbors = append(adj.neighbors, to)
+	g.adjacency[to].inDegree++
+	return true
+}
+
+// RemoveEdge removes the edge from one vertex to another.
+// In an undirected graph the edge is removed both ways.
+// Returns true if the edge was removed, false if it was not present.
+func (g *Graph[V]) RemoveEdge(from, to V) bool {
+	if !g.unlink(from, to) {
+		return false
+	}
+	if !g.directed && from != to {
+		g.unlink(to, from)
+	}
+	g.edgeCount--
+	return true
+}
+
+func (g *Graph[V]) unlink(from, to V) bool {
+	adj, ok := g.adjacency[from]
+	if !ok {
+		return false
+	}
+	if _, ok := adj.weights[to]; !ok {
+		return false
+	}
+	delete(adj.weights, to)
+	for i, u := range adj.neighbors {
+		if u == to {
+			adj.neighbors = append(adj.neighbors[:i], adj.neighbors[i+1:]...)
+			break
+		}
+	}
+	g.adjacency[to].inDegree--
+	return true
+}
+
+// HasEdge checks if there is an edge from one vertex to another.
+func (g *Graph[V]) HasEdge(from, to V) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the edge from one vertex to another.
+// The boolean result is false if there is no such edge.
+func (g *Graph[V]) Weight(from, to V) (float64, bool) {
+	adj, ok := g.adjacency[from]
+	if !ok {
+		return 0, false
+	}
+	weight, ok := adj.weights[to]
+	return weight, ok
+}
+
+// Neighbors returns the vertices that v has an edge to, in the order the edges were added,
+// or nil if v is not present.
+func (g *Graph[V]) Neighbors(v V) []V {
+	adj, ok := g.adjacency[v]
+	if !ok {
+		return nil
+	}
+	neighbors := make([]V, len(adj.neighbors))
+	copy(neighbors, adj.neighbors)
+	return neighbors
+}
+
+// Degree returns the number of edges leaving v, which in an undirected graph is the number of edges touching it.
+// A self-loop in an undirected graph is counted once.
+func (g *Graph[V]) Degree(v V) int {
+	adj, ok := g.adjacency[v]
+	if !ok {
+		return 0
+	}
+	return len(adj.neighbors)
+}
+
+// InDegree returns the number of edges entering v. In an undirected graph it equals Degree.
+func (g *Graph[V]) InDegree(v V) int {
+	adj, ok := g.adjacency[v]
+	if !ok {
+		return 0
+	}
+	return adj.inDegree
+}
+
+// Vertices returns a slice of all vertices in the order they were added.
+func (g *Graph[V]) Vertices() []V {
+	vertices := make([]V, len(g.vertices))
+	copy(vertices, g.vertices)
+	return vertices
+}
+
+// Edges returns a slice of all edges, grouped by source vertex in the order the vertices were added.
+// Each edge of an undirected graph is returned once, from the endpoint that was added first.
+func (g *Graph[V]) Edges() []Edge[V] {
+	edges := make([]Edge[V], 0, g.edgeCount)
+	seen := make(map[V]bool, len(g.vertices))
+	for _, from := range g.vertices {
+		seen[from] = true
+		adj := g.adjacency[from]
+		for _, to := range adj.neighbors {
+			if !g.directed && seen[to] && to != from {
+				continue
+			}
+			edges = append(edges, Edge[V]{From: from, To: to, Weight: adj.weights[to]})
+		}
+	}
+	return edges
+}
+
+// VertexCount returns the number of vertices.
+func (g *Graph[V]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// EdgeCount returns the number of edges. Each edge of an undirected graph is counted once.
+func (g *Graph[V]) EdgeCount() int {
+	return g.edgeCount
+}
+
+// Reverse returns a copy of the graph with the direction of every edge reversed.
+// The copy of an undirected graph is the same as the original.
+func (g *Graph[V]) Reverse() *Graph[V] {
+	r := &Graph[V]{directed: g.directed, adjacency: make(map[V]*adjacency[V], len(g.vertices))}
+	for _, v := range g.vertices {
+		r.AddVertex(v)
+	}
+	for _, e := range g.Edges() {
+		if g.directed {
+			r.AddWeightedEdge(e.To, e.From, e.Weight)
+		} else {
+			r.AddWeightedEdge(e.From, e.To, e.Weight)
+		}
+	}
+	return r
+}
+
+// Clone returns a copy of the graph.
+func (g *Graph[V]) Clone() *Graph[V] {
+	c := &Graph[V]{directed: g.directed, adjacency: make(map[V]*adjacency[V], len(g.vertices))}
+	for _, v := range g.vertices {
+		c.AddVertex(v)
+	}
+	for _, e := range g.Edges() {
+		c.AddWeightedEdge(e.From, e.To, e.Weight)
+	}
+	return c
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func assertEqual[T any](t *testing.T, got, want T) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestGraph_Directed(t *testing.T) {
+	g := NewDirected[string]()
+	if !g.AddEdge("a", "b") || !g.AddWeightedEdge("a", "c", 2.5) || g.AddEdge("a", "b") {
+		t.Error("AddEdge returned an unexpected result")
+	}
+	g.AddEdge("c", "a")
+	g.AddVertex("d")
+
+	assertEqual(t, g.Vertices(), []string{"a", "b", "c", "d"})
+	assertEqual(t, g.Neighbors("a"), []string{"b", "c"})
+	if g.VertexCount() != 4 || g.EdgeCount() != 3 {
+		t.Errorf("VertexCount() = %d, EdgeCount() = %d; want 4, 3", g.VertexCount(), g.EdgeCount())
+	}
+	if w, ok := g.Weight("a", "c"); !ok || w != 2.5 {
+		t.Errorf("Weight(a, c) = %v, %v; want 2.5, true", w, ok)
+	}
+	if g.HasEdge("b", "a") || g.Degree("a") != 2 || g.InDegree("a") != 1 || g.InDegree("d") != 0 {
+		t.Error("edge queries returned an unexpected result")
+	}
+	assertEqual(t, g.Reverse().Neighbors("a"), []string{"c"})
+
+	if !g.RemoveEdge("a", "b") || g.RemoveEdge("a", "b") || g.InDegree("b") != 0 {
+		t.Error("RemoveEdge(a, b) did not remove exactly once")
+	}
+	if !g.RemoveVertex("a") || g.HasVertex("a") || g.EdgeCount() != 0 || g.Degree("c") != 0 {
+		t.Error("RemoveVertex(a) did not remove the vertex and its edges")
+	}
+	assertEqual(t, g.Vertices(), []string{"b", "c", "d"})
+}
+
+func TestGraph_Undirected(t *testing.T) {
+	g := NewUndirected[int]()
+	g.AddWeightedEdge(1, 2, 4)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 3)
+	if g.AddEdge(2, 1) {
+		t.Error("AddEdge(2, 1) = true; want false as the edge exists")
+	}
+
+	if w, ok := g.Weight(2, 1); !ok || w != 4 {
+		t.Errorf("Weight(2, 1) = %v, %v; want 4, true", w, ok)
+	}
+	if g.EdgeCount() != 3 || g.Degree(2) != 2 || g.InDegree(2) != 2 || g.Degree(3) != 2 {
+		t.Error("counts returned an unexpected result")
+	}
+	assertEqual(t, g.Edges(), []Edge[int]{{1, 2, 4}, {2, 3, 1}, {3, 3, 1}})
+
+	clone := g.Clone()
+	g.RemoveEdge(3, 2)
+	if g.HasEdge(2, 3) || !clone.HasEdge(2, 3) || g.EdgeCount() != 2 {
+		t.Error("RemoveEdge(3, 2) did not remove both directions, or affected the clone")
+	}
+}
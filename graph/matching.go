@@ -0,0 +1,316 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// HopcroftKarp finds a maximum matching in a bipartite graph in O(E * sqrt(V)): the largest set of edges
+// of which no two share a vertex. left lists the vertices of one side; every neighbor of a left vertex
+// is on the other side. The matched edges are returned in the order of left, from the left vertex
+// to its partner. It panics if two left vertices are adjacent.
+//
+// Example:
+//  jobs := graph.NewUndirected[string]()
+//  jobs.AddEdge("ann", "cook")
+//  jobs.AddEdge("bob", "cook")
+//  jobs.AddEdge("bob", "wash")
+//  matching := jobs.HopcroftKarp([]string{"ann", "bob"})
+//  fmt.Println(len(matching)) // Output: 2
+func (g *Graph[V]) HopcroftKarp(left []V) []Edge[V] {
+	right, adjacency := g.bipartite(left)
+
+	const unmatched = -1
+	matchLeft := make([]int, len(left))
+	matchRight := make([]int, len(right))
+	for i := range matchLeft {
+		matchLeft[i] = unmatched
+	}
+	for j := range matchRight {
+		matchRight[j] = unmatched
+	}
+	dist := make([]int, len(left))
+
+	// layer labels left vertices by the length of the shortest alternating path from a free left vertex,
+	// and reports whether some augmenting path exists.
+	layer := func() bool {
+		queue := collections.NewQueue[int]()
+		for i := range left {
+			if matchLeft[i] == unmatched {
+				dist[i] = 0
+				queue.Enqueue(i)
+			} else {
+				dist[i] = -1
+			}
+		}
+		found := false
+		for !queue.IsEmpty() {
+			i := queue.Dequeue()
+			for _, j := range adjacency[i] {
+				next := matchRight[j]
+				if next == unmatched {
+					found = true
+				} else if dist[next] < 0 {
+					dist[next] = dist[i] + 1
+					queue.Enqueue(next)
+				}
+			}
+		}
+		return found
+	}
+
+	// augment looks for an augmenting path from left vertex i along the layers and flips it.
+	var augment func(i int) bool
+	augment = func(i int) bool {
+		for _, j := range adjacency[i] {
+			next := matchRight[j]
+			if next == unmatched || (dist[next] == dist[i]+1 && augment(next)) {
+				matchLeft[i] = j
+				matchRight[j] = i
+				return true
+			}
+		}
+		// No path through i in this phase; keep later searches from retrying it.
+		dist[i] = -1
+		return false
+	}
+
+	for layer() {
+		for i := range left {
+			if matchLeft[i] == unmatched {
+				augment(i)
+			}
+		}
+	}
+
+	var matching []Edge[V]
+	for i, j := range matchLeft {
+		if j != unmatched {
+			weight, _ := g.Weight(left[i], right[j])
+			matching = append(matching, Edge[V]{From: left[i], To: right[j], Weight: weight})
+		}
+	}
+	return matching
+}
+
+// bipartite numbers the neighbors of the left vertices, which form the right side, in the order they are first seen,
+// and returns them with the neighbors of each left vertex as positions among them.
+// It panics if two left vertices are adjacent.
+func (g *Graph[V]) bipartite(left []V) ([]V, [][]int) {
+	leftIndex := make(map[V]int, len(left))
+	for i, v := range left {
+		leftIndex[v] = i
+	}
+	rightIndex := make(map[V]int)
+	var right []V
+	adjacency := make([][]int, len(left))
+	for i, v := range left {
+		adj, ok := g.adjacency[v]
+		if !ok {
+			continue
+		}
+		for _, to := range adj.neighbors {
+			if _, isLeft := leftIndex[to]; isLeft {
+				panic("Left vertices must only have edges to right vertices.")
+			}
+			j, seen := rightIndex[to]
+			if !seen {
+				j = len(right)
+				rightIndex[to] = j
+				right = append(right, to)
+			}
+			adjacency[i] = append(adjacency[i], j)
+		}
+	}
+	return right, adjacency
+}
+
+// MinWeightMatching finds a matching in a bipartite graph with as many edges as possible and, among those,
+// the smallest total weight, by solving the assignment problem with Hungarian over the edge weights,
+// where missing edges are forbidden pairs. left lists the vertices of one side as in HopcroftKarp.
+// The matched edges are returned in the order of left, from the left vertex to its partner.
+// For the largest total weight instead, use a graph with negated weights.
+// It panics if two left vertices are adjacent or an edge weight is NaN or negative infinity.
+//
+// Example:
+//  shifts := graph.NewUndirected[string]()
+//  shifts.AddWeightedEdge("ann", "early", 3)
+//  shifts.AddWeightedEdge("ann", "late", 1)
+//  shifts.AddWeightedEdge("bob", "late", 2)
+//  matching := shifts.MinWeightMatching([]string{"ann", "bob"})
+//  fmt.Println(matching) // Output: [{ann early 3} {bob late 2}]
+func (g *Graph[V]) MinWeightMatching(left []V) []Edge[V] {
+	right, adjacency := g.bipartite(left)
+	cost := make([][]float64, len(left))
+	for i, adj := range adjacency {
+		cost[i] = make([]float64, len(right))
+		for j := range cost[i] {
+			cost[i][j] = math.Inf(1)
+		}
+		for _, j := range adj {
+			cost[i][j] = g.adjacency[left[i]].weights[right[j]]
+		}
+	}
+
+	assignment, _ := Hungarian(cost)
+	var matching []Edge[V]
+	for i, j := range assignment {
+		if j >= 0 {
+			matching = append(matching, Edge[V]{From: left[i], To: right[j], Weight: cost[i][j]})
+		}
+	}
+	return matching
+}
+
+// Hungarian solves the assignment problem with the Hungarian algorithm in O(n^2 * m) for an n by m cost matrix
+// with n <= m, or the transposed bound otherwise: it assigns rows to distinct columns so that as many rows
+// as possible are assigned and the total cost is as small as possible. To maximize a total instead, negate the costs.
+// A cost of positive infinity forbids assigning the row to the column. It returns the column assigned to each row,
+// or -1 for rows left unassigned because there are too few columns or too few allowed pairs, and the total cost.
+// Ties are broken deterministically in favor of lower column indexes.
+// It panics if the rows of cost have different lengths or a cost is NaN or negative infinity.
+//
+// Example:
+//  cost := [][]float64{
+//  	{4, 1, 3},
+//  	{2, 0, 5},
+//  	{3, 2, 2},
+//  }
+//  assignment, total := graph.Hungarian(cost)
+//  fmt.Println(assignment, total) // Output: [1 0 2] 5
+func Hungarian(cost [][]float64) ([]int, float64) {
+	rows := len(cost)
+	if rows == 0 {
+		return nil, 0
+	}
+	cols := len(cost[0])
+	for _, row := range cost {
+		if len(row) != cols {
+			panic("Cost matrix must be rectangular.")
+		}
+		for _, c := range row {
+			if math.IsNaN(c) || math.IsInf(c, -1) {
+				panic("Costs must not be NaN or negative infinity.")
+			}
+		}
+	}
+
+	if rows > cols {
+		// Assign every column to a row of the transposed matrix instead.
+		transposed := make([][]float64, cols)
+		for j := range transposed {
+			transposed[j] = make([]float64, rows)
+			for i := range cost {
+				transposed[j][i] = cost[i][j]
+			}
+		}
+		byColumn, total := Hungarian(transposed)
+		assignment := make([]int, rows)
+		for i := range assignment {
+			assignment[i] = -1
+		}
+		for j, i := range byColumn {
+			if i >= 0 {
+				assignment[i] = j
+			}
+		}
+		return assignment, total
+	}
+
+	// Shortest augmenting paths with vertex potentials u (rows) and v (columns), using one-based positions
+	// so that column 0 can stand for the row being added. p[j] is the row assigned to column j.
+	// Every row is assigned, to a forbidden column if need be; as forbidden pairs weigh more than any sum of costs,
+	// the fewest possible are used, and they are dropped afterwards.
+	u := make([]assignmentCost, rows+1)
+	v := make([]assignmentCost, cols+1)
+	p := make([]int, cols+1)
+	way := make([]int, cols+1)
+	minSlack := make([]assignmentCost, cols+1)
+	used := make([]bool, cols+1)
+	for i := 1; i <= rows; i++ {
+		p[0] = i
+		j0 := 0
+		for j := range minSlack {
+			minSlack[j] = unreachedCost
+			used[j] = false
+		}
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], unreachedCost, 0
+			for j := 1; j <= cols; j++ {
+				if used[j] {
+					continue
+				}
+				if slack := newAssignmentCost(cost[i0-1][j-1]).sub(u[i0]).sub(v[j]); slack.less(minSlack[j]) {
+					minSlack[j] = slack
+					way[j] = j0
+				}
+				if minSlack[j].less(delta) {
+					delta = minSlack[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= cols; j++ {
+				if used[j] {
+					u[p[j]] = u[p[j]].add(delta)
+					v[j] = v[j].sub(delta)
+				} else {
+					minSlack[j] = minSlack[j].sub(delta)
+				}
+			}
+			j0 = j1
+		}
+		// Flip the assignments along the augmenting path.
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, rows)
+	for i := range assignment {
+		assignment[i] = -1
+	}
+	total := 0.0
+	for j := 1; j <= cols; j++ {
+		if p[j] != 0 && !math.IsInf(cost[p[j]-1][j-1], 1) {
+			assignment[p[j]-1] = j - 1
+			total += cost[p[j]-1][j-1]
+		}
+	}
+	return assignment, total
+}
+
+// assignmentCost is a cost in the Hungarian algorithm, ordered first by the number of forbidden pairs it counts
+// and then by the sum of the other costs, so that no sum of costs outweighs a forbidden pair.
+type assignmentCost struct {
+	forbidden int
+	cost      float64
+}
+
+// unreachedCost exceeds every cost the algorithm computes.
+var unreachedCost = assignmentCost{forbidden: math.MaxInt32}
+
+func newAssignmentCost(c float64) assignmentCost {
+	if math.IsInf(c, 1) {
+		return assignmentCost{forbidden: 1}
+	}
+	return assignmentCost{cost: c}
+}
+
+func (a assignmentCost) add(b assignmentCost) assignmentCost {
+	return assignmentCost{forbidden: a.forbidden + b.forbidden, cost: a.cost + b.cost}
+}
+
+func (a assignmentCost) sub(b assignmentCost) assignmentCost {
+	return assignmentCost{forbidden: a.forbidden - b.forbidden, cost: a.cost - b.cost}
+}
+
+func (a assignmentCost) less(b assignmentCost) bool {
+	if a.forbidden != b.forbidden {
+		return a.forbidden < b.forbidden
+	}
+	return a.cost < b.cost
+}
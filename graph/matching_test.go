@@ -0,0 +1,256 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHopcroftKarp(t *testing.T) {
+	g := NewUndirected[string]()
+	g.AddEdge("ann", "cook")
+	g.AddEdge("bob", "cook")
+	g.AddEdge("bob", "wash")
+	g.AddEdge("cid", "wash")
+	g.AddEdge("cid", "iron")
+	g.AddEdge("dee", "cook")
+	matching := g.HopcroftKarp([]string{"ann", "bob", "cid", "dee", "eve"})
+	if len(matching) != 3 {
+		t.Fatalf("HopcroftKarp() matched %d; want 3", len(matching))
+	}
+	used := make(map[string]bool)
+	for _, e := range matching {
+		if !g.HasEdge(e.From, e.To) || used[e.To] {
+			t.Errorf("matching %v is not valid", matching)
+		}
+		used[e.To] = true
+	}
+}
+
+func TestHopcroftKarp_AgreesWithMaxFlow(t *testing.T) {
+	r := rand.New(rand.NewSource(37))
+	for round := 0; round < 30; round++ {
+		g := NewDirected[int]()
+		net := NewFlowNetwork[int]()
+		left := make([]int, 15)
+		for i := range left {
+			left[i] = i
+			net.AddEdge(-1, i, 1)
+		}
+		for j := 100; j < 115; j++ {
+			net.AddEdge(j, -2, 1)
+		}
+		for i := 0; i < 30; i++ {
+			from, to := r.Intn(15), 100+r.Intn(15)
+			if g.AddEdge(from, to) {
+				net.AddEdge(from, to, 1)
+			}
+		}
+		if got, want := len(g.HopcroftKarp(left)), net.Dinic(-1, -2).Value(); int64(got) != want {
+			t.Fatalf("HopcroftKarp matched %d; max flow is %d", got, want)
+		}
+	}
+}
+
+func TestHopcroftKarp_PanicsOnAdjacentLeftVertices(t *testing.T) {
+	g := NewUndirected[int]()
+	g.AddEdge(1, 2)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for adjacent left vertices, but did not panic")
+		}
+	}()
+	g.HopcroftKarp([]int{1, 2})
+}
+
+func TestHungarian(t *testing.T) {
+	assignment, total := Hungarian([][]float64{
+		{4, 1, 3},
+		{2, 0, 5},
+		{3, 2, 2},
+	})
+	assertEqual(t, assignment, []int{1, 0, 2})
+	if total != 5 {
+		t.Errorf("total = %v; want 5", total)
+	}
+
+	// More rows than columns leaves the costliest row unassigned.
+	assignment, total = Hungarian([][]float64{{1, 9}, {9, 9}, {9, 2}})
+	assertEqual(t, assignment, []int{0, -1, 1})
+	if total != 3 {
+		t.Errorf("total = %v; want 3", total)
+	}
+
+	// Ties resolve the same way on every run.
+	assignment, _ = Hungarian([][]float64{{1, 1}, {1, 1}})
+	assertEqual(t, assignment, []int{0, 1})
+}
+
+func TestHungarian_MatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(41))
+	for round := 0; round < 50; round++ {
+		rows, cols := 1+r.Intn(5), 1+r.Intn(5)
+		cost := make([][]float64, rows)
+		for i := range cost {
+			cost[i] = make([]float64, cols)
+			for j := range cost[i] {
+				cost[i][j] = float64(r.Intn(20) - 5)
+			}
+		}
+
+		assignment, total := Hungarian(cost)
+		sum, usedColumns := 0.0, make(map[int]bool)
+		for i, j := range assignment {
+			if j < 0 {
+				continue
+			}
+			if usedColumns[j] {
+				t.Fatalf("column %d assigned twice in %v", j, assignment)
+			}
+			usedColumns[j] = true
+			sum += cost[i][j]
+		}
+		if len(usedColumns) != min(rows, cols) || sum != total {
+			t.Fatalf("assignment %v is incomplete or does not cost %v", assignment, total)
+		}
+		if want := bruteForceAssignment(cost, 0, make([]bool, cols), min(rows, cols)); total != want {
+			t.Fatalf("Hungarian total = %v; want %v for %v", total, want, cost)
+		}
+	}
+}
+
+// bruteForceAssignment returns the cheapest way to assign `remaining` of the rows from row onwards to unused columns.
+func bruteForceAssignment(cost [][]float64, row int, used []bool, remaining int) float64 {
+	if remaining == 0 {
+		return 0
+	}
+	best := 1e18
+	if len(cost)-row > remaining {
+		best = bruteForceAssignment(cost, row+1, used, remaining)
+	}
+	for j := range used {
+		if !used[j] {
+			used[j] = true
+			best = min(best, cost[row][j]+bruteForceAssignment(cost, row+1, used, remaining-1))
+			used[j] = false
+		}
+	}
+	return best
+}
+
+func TestHungarian_ForbiddenPairs(t *testing.T) {
+	inf := math.Inf(1)
+	assignment, total := Hungarian([][]float64{{inf, inf}, {1, 2}})
+	assertEqual(t, assignment, []int{-1, 0})
+	if total != 1 {
+		t.Errorf("total = %v; want 1", total)
+	}
+
+	// The cheap pair is given up so that both rows can be assigned.
+	assignment, total = Hungarian([][]float64{{1, inf}, {1, 5}})
+	assertEqual(t, assignment, []int{0, 1})
+	if total != 6 {
+		t.Errorf("total = %v; want 6", total)
+	}
+
+	assignment, total = Hungarian([][]float64{{inf}, {inf}, {3}})
+	assertEqual(t, assignment, []int{-1, -1, 0})
+	if total != 3 {
+		t.Errorf("total = %v; want 3", total)
+	}
+}
+
+func TestHungarian_ForbiddenPairsMatchBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(43))
+	for round := 0; round < 100; round++ {
+		rows, cols := 1+r.Intn(5), 1+r.Intn(5)
+		cost := make([][]float64, rows)
+		for i := range cost {
+			cost[i] = make([]float64, cols)
+			for j := range cost[i] {
+				cost[i][j] = float64(r.Intn(20) - 5)
+				if r.Intn(2) == 0 {
+					cost[i][j] = math.Inf(1)
+				}
+			}
+		}
+
+		assignment, total := Hungarian(cost)
+		count, sum := 0, 0.0
+		for i, j := range assignment {
+			if j >= 0 {
+				if math.IsInf(cost[i][j], 1) {
+					t.Fatalf("forbidden pair %d, %d assigned in %v", i, j, assignment)
+				}
+				count++
+				sum += cost[i][j]
+			}
+		}
+		wantCount, want := bruteForceFeasibleAssignment(cost, 0, make([]bool, cols))
+		if count != wantCount || sum != total || total != want {
+			t.Fatalf("Hungarian assigned %d rows for %v; want %d rows for %v in %v", count, total, wantCount, want, cost)
+		}
+	}
+}
+
+// bruteForceFeasibleAssignment returns the most rows from row onwards that can be assigned to unused columns
+// without a forbidden pair, and the cheapest cost of assigning that many.
+func bruteForceFeasibleAssignment(cost [][]float64, row int, used []bool) (int, float64) {
+	if row == len(cost) {
+		return 0, 0
+	}
+	bestCount, best := bruteForceFeasibleAssignment(cost, row+1, used)
+	for j := range used {
+		if used[j] || math.IsInf(cost[row][j], 1) {
+			continue
+		}
+		used[j] = true
+		count, c := bruteForceFeasibleAssignment(cost, row+1, used)
+		used[j] = false
+		if count+1 > bestCount || (count+1 == bestCount && c+cost[row][j] < best) {
+			bestCount, best = count+1, c+cost[row][j]
+		}
+	}
+	return bestCount, best
+}
+
+func TestHungarian_PanicsOnNaN(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a NaN cost, but did not panic")
+		}
+	}()
+	Hungarian([][]float64{{1, math.NaN()}})
+}
+
+func TestMinWeightMatching(t *testing.T) {
+	g := NewUndirected[string]()
+	g.AddWeightedEdge("ann", "early", 3)
+	g.AddWeightedEdge("ann", "late", 1)
+	g.AddWeightedEdge("bob", "late", 2)
+	g.AddWeightedEdge("cid", "late", 1)
+	g.AddVertex("dee")
+	matching := g.MinWeightMatching([]string{"ann", "bob", "cid", "dee"})
+	assertEqual(t, matching, []Edge[string]{{From: "ann", To: "early", Weight: 3}, {From: "cid", To: "late", Weight: 1}})
+
+	if matching := NewDirected[int]().MinWeightMatching([]int{1, 2}); len(matching) != 0 {
+		t.Errorf("MinWeightMatching() on an empty graph = %v; want none", matching)
+	}
+}
+
+func TestMinWeightMatching_AgreesWithHopcroftKarp(t *testing.T) {
+	r := rand.New(rand.NewSource(47))
+	for round := 0; round < 30; round++ {
+		g := NewDirected[int]()
+		left := make([]int, 10)
+		for i := range left {
+			left[i] = i
+		}
+		for i := 0; i < 20; i++ {
+			g.AddWeightedEdge(r.Intn(10), 100+r.Intn(10), float64(r.Intn(9)))
+		}
+		if got, want := len(g.MinWeightMatching(left)), len(g.HopcroftKarp(left)); got != want {
+			t.Fatalf("MinWeightMatching matched %d; HopcroftKarp matched %d", got, want)
+		}
+	}
+}
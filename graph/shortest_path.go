@@ -0,0 +1,301 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// NegativeCycleError is returned by BellmanFord when a cycle of negative total weight is reachable from the source,
+// so that some distances are unbounded.
+type NegativeCycleError[V comparable] struct {
+	// Cycle lists the vertices of the cycle; each has an edge to the next, and the last has an edge to the first.
+	Cycle []V
+}
+
+func (e *NegativeCycleError[V]) Error() string {
+	return formatCycle("graph: negative cycle ", e.Cycle)
+}
+
+// ShortestPaths holds the shortest distances from a source vertex to every reachable vertex,
+// and a shortest path tree from which the paths themselves can be rebuilt.
+type ShortestPaths[V comparable] struct {
+	source    V
+	distances map[V]float64
+	parents   map[V]V
+}
+
+// Source returns the vertex the paths start from.
+func (sp *ShortestPaths[V]) Source() V {
+	return sp.source
+}
+
+// DistanceTo returns the total weight of a shortest path from the source to v.
+// The boolean result is false if v is not reachable.
+func (sp *ShortestPaths[V]) DistanceTo(v V) (float64, bool) {
+	d, ok := sp.distances[v]
+	return d, ok
+}
+
+// HasPathTo checks if v is reachable from the source.
+func (sp *ShortestPaths[V]) HasPathTo(v V) bool {
+	_, ok := sp.distances[v]
+	return ok
+}
+
+// PathTo returns the vertices on a shortest path from the source to v, including both ends.
+// The boolean result is false if v is not reachable.
+func (sp *ShortestPaths[V]) PathTo(v V) ([]V, bool) {
+	if !sp.HasPathTo(v) {
+		return nil, false
+	}
+	return buildPath(sp.parents, v), true
+}
+
+// dijkstraEntry is a vertex waiting in the priority queue with its tentative distance.
+type dijkstraEntry[V comparable] struct {
+	v        V
+	distance float64
+	// position breaks ties between equal distances by insertion order, so results are deterministic.
+	position int
+}
+
+func lessDijkstraEntry[V comparable](a, b dijkstraEntry[V]) bool {
+	if a.distance != b.distance {
+		return a.distance < b.distance
+	}
+	return a.position < b.position
+}
+
+// Dijkstra finds shortest paths from source to every reachable vertex in O((V + E) log V).
+// It panics if a reachable edge has a negative weight; use BellmanFord for such graphs.
+//
+// Example:
+//  paths := g.Dijkstra("home")
+//  route, _ := paths.PathTo("office")
+//  km, _ := paths.DistanceTo("office")
+func (g *Graph[V]) Dijkstra(source V) *ShortestPaths[V] {
+	return g.search(source, nil, func(V) float64 { return 0 })
+}
+
+// AStar finds a shortest path from source to target, exploring vertices in order of their distance
+// from the source plus heuristic's estimate of their remaining distance to the target.
+// The path is shortest if heuristic is consistent: it is 0 at target, and its estimate for a vertex never exceeds
+// the weight of an edge leaving the vertex plus the estimate for the edge's end. It returns the path, including both ends,
+// and its total weight; the boolean result is false if target is not reachable.
+// It panics if an explored edge has a negative weight.
+//
+// Example:
+//  path, cost, ok := grid.AStar(start, goal, func(p point) float64 {
+//  	return math.Abs(float64(p.x-goal.x)) + math.Abs(float64(p.y-goal.y))
+//  })
+func (g *Graph[V]) AStar(source, target V, heuristic func(v V) float64) ([]V, float64, bool) {
+	sp := g.search(source, &target, heuristic)
+	path, ok := sp.PathTo(target)
+	if !ok {
+		return nil, 0, false
+	}
+	return path, sp.distances[target], true
+}
+
+// search runs Dijkstra's algorithm with the queue ordered by distance plus heuristic,
+// stopping early once target, if given, is settled.
+func (g *Graph[V]) search(source V, target *V, heuristic func(v V) float64) *ShortestPaths[V] {
+	sp := &ShortestPaths[V]{source: source, distances: make(map[V]float64), parents: make(map[V]V)}
+	if !g.HasVertex(source) {
+		return sp
+	}
+
+	position := make(map[V]int, len(g.vertices))
+	for i, v := range g.vertices {
+		position[v] = i
+	}
+	queue := collections.NewIndexedPriorityQueue(lessDijkstraEntry[V])
+	handles := make(map[V]*collections.PriorityQueueHandle[dijkstraEntry[V]])
+	settled := collections.NewHashSet[V]()
+
+	sp.distances[source] = 0
+	handles[source] = queue.Push(dijkstraEntry[V]{v: source, distance: heuristic(source), position: position[source]})
+	for !queue.IsEmpty() {
+		v := queue.Pop().v
+		settled.Add(v)
+		if target != nil && v == *target {
+			break
+		}
+
+		adj := g.adjacency[v]
+		for _, to := range adj.neighbors {
+			weight := adj.weights[to]
+			if weight < 0 {
+				panic("Shortest path search requires non-negative edge weights.")
+			}
+			if settled.Contains(to) {
+				continue
+			}
+			d := sp.distances[v] + weight
+			if old, seen := sp.distances[to]; seen && d >= old {
+				continue
+			}
+			sp.distances[to] = d
+			sp.parents[to] = v
+			entry := dijkstraEntry[V]{v: to, distance: d + heuristic(to), position: position[to]}
+			if h, queued := handles[to]; queued && queue.Contains(h) {
+				queue.Update(h, entry)
+			} else {
+				handles[to] = queue.Push(entry)
+			}
+		}
+	}
+	return sp
+}
+
+// BellmanFord finds shortest paths from source to every reachable vertex in O(V * E), allowing negative weights.
+// It returns a *NegativeCycleError if a cycle of negative total weight is reachable from source.
+// In an undirected graph a negative edge is such a cycle, as it can be crossed back and forth.
+func (g *Graph[V]) BellmanFord(source V) (*ShortestPaths[V], error) {
+	sp := &ShortestPaths[V]{source: source, distances: make(map[V]float64), parents: make(map[V]V)}
+	if !g.HasVertex(source) {
+		return sp, nil
+	}
+	sp.distances[source] = 0
+
+	// relax lowers distances through every edge once and returns a vertex whose distance changed, if any.
+	relax := func() (V, bool) {
+		var changed V
+		anyChanged := false
+		for _, from := range g.vertices {
+			d, ok := sp.distances[from]
+			if !ok {
+				continue
+			}
+			adj := g.adjacency[from]
+			for _, to := range adj.neighbors {
+				if old, seen := sp.distances[to]; !seen || d+adj.weights[to] < old {
+					sp.distances[to] = d + adj.weights[to]
+					sp.parents[to] = from
+					changed, anyChanged = to, true
+				}
+			}
+		}
+		return changed, anyChanged
+	}
+
+	for i := 1; i < len(g.vertices); i++ {
+		if _, changed := relax(); !changed {
+			return sp, nil
+		}
+	}
+	v, changed := relax()
+	if !changed {
+		return sp, nil
+	}
+
+	// v was lowered after V-1 rounds, so following parents back V times lands on the cycle.
+	for i := 0; i < len(g.vertices); i++ {
+		v = sp.parents[v]
+	}
+	cycle := []V{v}
+	for u := sp.parents[v]; u != v; u = sp.parents[u] {
+		cycle = append(cycle, u)
+	}
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return nil, &NegativeCycleError[V]{Cycle: cycle}
+}
+
+// AllPairsShortestPaths holds the shortest distances between every pair of vertices.
+type AllPairsShortestPaths[V comparable] struct {
+	index     map[V]int
+	vertices  []V
+	distances [][]float64
+	// next[i][j] is the position of the vertex after i on a shortest path from i to j, or -1 if there is none.
+	next [][]int
+}
+
+// FloydWarshall finds shortest paths between every pair of vertices in O(V^3) time and O(V^2) memory,
+// allowing negative weights. It suits small, dense graphs.
+// If the graph has a negative cycle, HasNegativeCycle of the result returns true and its distances are not shortest.
+func (g *Graph[V]) FloydWarshall() *AllPairsShortestPaths[V] {
+	n := len(g.vertices)
+	ap := &AllPairsShortestPaths[V]{
+		index:     make(map[V]int, n),
+		vertices:  g.Vertices(),
+		distances: make([][]float64, n),
+		next:      make([][]int, n),
+	}
+	for i, v := range g.vertices {
+		ap.index[v] = i
+	}
+	for i, v := range g.vertices {
+		ap.distances[i] = make([]float64, n)
+		ap.next[i] = make([]int, n)
+		for j := range ap.distances[i] {
+			ap.distances[i][j] = math.Inf(1)
+			ap.next[i][j] = -1
+		}
+		ap.distances[i][i] = 0
+		ap.next[i][i] = i
+		adj := g.adjacency[v]
+		for _, to := range adj.neighbors {
+			j := ap.index[to]
+			if w := adj.weights[to]; w < ap.distances[i][j] {
+				ap.distances[i][j] = w
+				ap.next[i][j] = j
+			}
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(ap.distances[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if d := ap.distances[i][k] + ap.distances[k][j]; d < ap.distances[i][j] {
+					ap.distances[i][j] = d
+					ap.next[i][j] = ap.next[i][k]
+				}
+			}
+		}
+	}
+	return ap
+}
+
+// Distance returns the total weight of a shortest path from one vertex to another.
+// The boolean result is false if to is not reachable from from.
+func (ap *AllPairsShortestPaths[V]) Distance(from, to V) (float64, bool) {
+	i, ok := ap.index[from]
+	j, ok2 := ap.index[to]
+	if !ok || !ok2 || math.IsInf(ap.distances[i][j], 1) {
+		return 0, false
+	}
+	return ap.distances[i][j], true
+}
+
+// Path returns the vertices on a shortest path from one vertex to another, including both ends.
+// The boolean result is false if to is not reachable from from.
+func (ap *AllPairsShortestPaths[V]) Path(from, to V) ([]V, bool) {
+	i, ok := ap.index[from]
+	j, ok2 := ap.index[to]
+	if !ok || !ok2 || ap.next[i][j] < 0 {
+		return nil, false
+	}
+	path := []V{from}
+	// A path never needs more than V vertices; the bound stops the walk around a negative cycle.
+	for i != j && len(path) <= len(ap.vertices) {
+		i = ap.next[i][j]
+		path = append(path, ap.vertices[i])
+	}
+	return path, true
+}
+
+// HasNegativeCycle checks if the graph has a cycle of negative total weight.
+func (ap *AllPairsShortestPaths[V]) HasNegativeCycle() bool {
+	for i := range ap.distances {
+		if ap.distances[i][i] < 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// roads returns a small weighted directed graph with two routes from a to e.
+func roads() *Graph[string] {
+	g := NewDirected[string]()
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("c", "b", 2)
+	g.AddWeightedEdge("b", "d", 1)
+	g.AddWeightedEdge("c", "d", 5)
+	g.AddWeightedEdge("d", "e", 3)
+	g.AddVertex("z")
+	return g
+}
+
+func TestDijkstra(t *testing.T) {
+	paths := roads().Dijkstra("a")
+	if d, ok := paths.DistanceTo("e"); !ok || d != 7 {
+		t.Errorf("DistanceTo(e) = %v, %v; want 7, true", d, ok)
+	}
+	path, _ := paths.PathTo("e")
+	assertEqual(t, path, []string{"a", "c", "b", "d", "e"})
+	if paths.HasPathTo("z") || paths.Source() != "a" {
+		t.Error("unexpected reachability or source")
+	}
+	if _, ok := paths.PathTo("z"); ok {
+		t.Error("PathTo(z) = true; want false")
+	}
+}
+
+func TestDijkstra_PanicsOnNegativeWeight(t *testing.T) {
+	g := NewDirected[int]()
+	g.AddWeightedEdge(1, 2, -1)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a negative weight, but did not panic")
+		}
+	}()
+	g.Dijkstra(1)
+}
+
+func TestAStar(t *testing.T) {
+	type point struct{ x, y int }
+	const size = 10
+	grid := NewUndirected[point]()
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			// A wall at x = 5 with a gap at y = 9.
+			if x == 5 && y != 9 {
+				continue
+			}
+			if x+1 < size && !(x+1 == 5 && y != 9) {
+				grid.AddEdge(point{x, y}, point{x + 1, y})
+			}
+			if y+1 < size && x != 5 {
+				grid.AddEdge(point{x, y}, point{x, y + 1})
+			}
+		}
+	}
+
+	goal := point{9, 0}
+	path, cost, ok := grid.AStar(point{0, 0}, goal, func(p point) float64 {
+		return math.Abs(float64(p.x-goal.x)) + math.Abs(float64(p.y-goal.y))
+	})
+	if !ok || cost != 27 || len(path) != 28 {
+		t.Fatalf("AStar() = %d vertices, cost %v, %v; want 28 vertices, cost 27, true", len(path), cost, ok)
+	}
+	if d, _ := grid.Dijkstra(point{0, 0}).DistanceTo(goal); d != cost {
+		t.Errorf("AStar cost %v differs from Dijkstra distance %v", cost, d)
+	}
+	if _, _, ok := grid.AStar(point{0, 0}, point{5, 0}, func(point) float64 { return 0 }); ok {
+		t.Error("AStar to a vertex that is not present = true; want false")
+	}
+}
+
+func TestBellmanFord(t *testing.T) {
+	g := roads()
+	g.AddWeightedEdge("b", "c", -1)
+	g.RemoveEdge("c", "b")
+	paths, err := g.BellmanFord("a")
+	if err != nil {
+		t.Fatalf("BellmanFord() error = %v", err)
+	}
+	if d, _ := paths.DistanceTo("c"); d != 1 {
+		t.Errorf("DistanceTo(c) = %v; want 1", d)
+	}
+	if d, _ := paths.DistanceTo("e"); d != 8 {
+		t.Errorf("DistanceTo(e) = %v; want 8", d)
+	}
+
+	g.AddWeightedEdge("d", "b", -3)
+	_, err = g.BellmanFord("a")
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("BellmanFord() error = %v; want a *NegativeCycleError", err)
+	}
+	if len(cycleErr.Cycle) != 2 {
+		t.Errorf("Cycle = %v; want b and d", cycleErr.Cycle)
+	}
+	for i, v := range cycleErr.Cycle {
+		if !g.HasEdge(v, cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]) {
+			t.Errorf("Cycle %v is not a cycle of the graph", cycleErr.Cycle)
+		}
+	}
+}
+
+func TestFloydWarshall(t *testing.T) {
+	all := roads().FloydWarshall()
+	if d, ok := all.Distance("a", "e"); !ok || d != 7 {
+		t.Errorf("Distance(a, e) = %v, %v; want 7, true", d, ok)
+	}
+	path, _ := all.Path("c", "e")
+	assertEqual(t, path, []string{"c", "b", "d", "e"})
+	if _, ok := all.Distance("e", "a"); ok {
+		t.Error("Distance(e, a) = true; want false")
+	}
+	if all.HasNegativeCycle() {
+		t.Error("HasNegativeCycle() = true; want false")
+	}
+
+	g := roads()
+	g.AddWeightedEdge("d", "b", -2)
+	if !g.FloydWarshall().HasNegativeCycle() {
+		t.Error("HasNegativeCycle() = false; want true")
+	}
+}
+
+func TestShortestPaths_AgreeOnRandomGraphs(t *testing.T) {
+	r := rand.New(rand.NewSource(23))
+	for round := 0; round < 20; round++ {
+		g := NewDirected[int]()
+		for i := 0; i < 30; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < 120; i++ {
+			g.AddWeightedEdge(r.Intn(30), r.Intn(30), float64(r.Intn(20)))
+		}
+
+		all := g.FloydWarshall()
+		dijkstra := g.Dijkstra(0)
+		bellmanFord, err := g.BellmanFord(0)
+		if err != nil {
+			t.Fatalf("BellmanFord() error = %v", err)
+		}
+		for v := 0; v < 30; v++ {
+			want, reachable := all.Distance(0, v)
+			for name, paths := range map[string]*ShortestPaths[int]{"Dijkstra": dijkstra, "BellmanFord": bellmanFord} {
+				got, ok := paths.DistanceTo(v)
+				if ok != reachable || got != want {
+					t.Fatalf("%s DistanceTo(%d) = %v, %v; want %v, %v", name, v, got, ok, want, reachable)
+				}
+				if !ok {
+					continue
+				}
+				path, _ := paths.PathTo(v)
+				total := 0.0
+				for i := 1; i < len(path); i++ {
+					w, _ := g.Weight(path[i-1], path[i])
+					total += w
+				}
+				if path[0] != 0 || path[len(path)-1] != v || total != want {
+					t.Fatalf("%s PathTo(%d) = %v with weight %v; want weight %v", name, v, path, total, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/VikashChauhan51/collections"
+)
+
+// Kruskal returns the edges of a minimum spanning forest of an undirected graph, one tree for each
+// connected component, and their total weight, in O(E log E). Edges are returned in the order they were
+// chosen, lightest first; among edges of equal weight the one returned first by Edges is preferred.
+// It panics if the graph is directed.
+//
+// Example:
+//  tree, cost := network.Kruskal()
+func (g *Graph[V]) Kruskal() ([]Edge[V], float64) {
+	if g.directed {
+		panic("Minimum spanning trees require an undirected graph.")
+	}
+
+	edges := g.Edges()
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].Weight < edges[j].Weight })
+	components := collections.NewDisjointSet[V]()
+	for _, v := range g.vertices {
+		components.MakeSet(v)
+	}
+
+	var tree []Edge[V]
+	total := 0.0
+	for _, e := range edges {
+		if components.Union(e.From, e.To) {
+			tree = append(tree, e)
+			total += e.Weight
+		}
+	}
+	return tree, total
+}
+
+// primEntry is a candidate edge waiting in the priority queue.
+type primEntry[V comparable] struct {
+	edge Edge[V]
+	// seq breaks ties between equal weights by discovery order, so results are deterministic.
+	seq int
+}
+
+func lessPrimEntry[V comparable](a, b primEntry[V]) bool {
+	if a.edge.Weight != b.edge.Weight {
+		return a.edge.Weight < b.edge.Weight
+	}
+	return a.seq < b.seq
+}
+
+// Prim returns the edges of a minimum spanning forest of an undirected graph, one tree for each
+// connected component, and their total weight, in O(E log E). Each tree is grown from its earliest added vertex,
+// and edges are returned in the order they were added to the trees.
+// It panics if the graph is directed.
+func (g *Graph[V]) Prim() ([]Edge[V], float64) {
+	if g.directed {
+		panic("Minimum spanning trees require an undirected graph.")
+	}
+
+	var tree []Edge[V]
+	total := 0.0
+	inTree := collections.NewHashSet[V]()
+	queue := collections.NewPriorityQueue(lessPrimEntry[V])
+	seq := 0
+	visit := func(v V) {
+		inTree.Add(v)
+		adj := g.adjacency[v]
+		for _, to := range adj.neighbors {
+			if !inTree.Contains(to) {
+				queue.Push(primEntry[V]{edge: Edge[V]{From: v, To: to, Weight: adj.weights[to]}, seq: seq})
+				seq++
+			}
+		}
+	}
+
+	for _, root := range g.vertices {
+		if inTree.Contains(root) {
+			continue
+		}
+		visit(root)
+		for !queue.IsEmpty() {
+			e := queue.Pop().edge
+			if inTree.Contains(e.To) {
+				continue
+			}
+			tree = append(tree, e)
+			total += e.Weight
+			visit(e.To)
+		}
+	}
+	return tree, total
+}
@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	g := NewUndirected[string]()
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("b", "c", 2)
+	g.AddWeightedEdge("b", "d", 5)
+	g.AddWeightedEdge("c", "d", 8)
+	g.AddWeightedEdge("d", "e", 3)
+	g.AddWeightedEdge("x", "y", 7)
+
+	tree, total := g.Kruskal()
+	assertEqual(t, tree, []Edge[string]{{"a", "c", 1}, {"b", "c", 2}, {"d", "e", 3}, {"b", "d", 5}, {"x", "y", 7}})
+	if total != 18 {
+		t.Errorf("Kruskal total = %v; want 18", total)
+	}
+
+	tree, total = g.Prim()
+	assertEqual(t, tree, []Edge[string]{{"a", "c", 1}, {"c", "b", 2}, {"b", "d", 5}, {"d", "e", 3}, {"x", "y", 7}})
+	if total != 18 {
+		t.Errorf("Prim total = %v; want 18", total)
+	}
+}
+
+func TestMinimumSpanningTree_AgreeOnRandomGraphs(t *testing.T) {
+	r := rand.New(rand.NewSource(29))
+	for round := 0; round < 20; round++ {
+		g := NewUndirected[int]()
+		for i := 0; i < 100; i++ {
+			g.AddWeightedEdge(r.Intn(40), r.Intn(40), float64(r.Intn(50)))
+		}
+		kruskal, kruskalTotal := g.Kruskal()
+		prim, primTotal := g.Prim()
+		if kruskalTotal != primTotal || len(kruskal) != len(prim) {
+			t.Fatalf("Kruskal gave %d edges weighing %v, Prim %d edges weighing %v", len(kruskal), kruskalTotal, len(prim), primTotal)
+		}
+		if want := g.VertexCount() - len(g.ConnectedComponents()); len(kruskal) != want {
+			t.Fatalf("spanning forest has %d edges; want %d", len(kruskal), want)
+		}
+	}
+}
+
+func TestKruskal_PanicsOnDirected(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a directed graph, but did not panic")
+		}
+	}()
+	NewDirected[int]().Kruskal()
+}
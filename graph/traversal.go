@@ -0,0 +1,185 @@
+package graph
+
+import "github.com/VikashChauhan51/collections"
+
+// BFSIterator visits the vertices reachable from a start vertex in breadth-first order,
+// so vertices are visited in order of their distance in edges from the start.
+type BFSIterator[V comparable] struct {
+	g       *Graph[V]
+	queue   *collections.Queue[V]
+	visited *collections.HashSet[V]
+	parents map[V]V
+	depths  map[V]int
+}
+
+// BFS creates an iterator over the vertices reachable from start in breadth-first order.
+// Neighbors are visited in the order their edges were added. The iterator is empty if start is not present.
+//
+// Example:
+//  it := g.BFS("a")
+//  for it.HasNext() {
+//  	v, _ := it.Next()
+//  	fmt.Println(v, it.Depth(v))
+//  }
+func (g *Graph[V]) BFS(start V) *BFSIterator[V] {
+	it := &BFSIterator[V]{
+		g:       g,
+		queue:   collections.NewQueue[V](),
+		visited: collections.NewHashSet[V](),
+		parents: make(map[V]V),
+		depths:  make(map[V]int),
+	}
+	if g.HasVertex(start) {
+		it.visited.Add(start)
+		it.depths[start] = 0
+		it.queue.Enqueue(start)
+	}
+	return it
+}
+
+// HasNext checks if there are more vertices to visit.
+func (it *BFSIterator[V]) HasNext() bool {
+	return !it.queue.IsEmpty()
+}
+
+// Next returns the next vertex.
+// The boolean result is false if every reachable vertex has been visited.
+func (it *BFSIterator[V]) Next() (V, bool) {
+	if it.queue.IsEmpty() {
+		var zeroValue V
+		return zeroValue, false
+	}
+	v := it.queue.Dequeue()
+	for _, to := range it.g.adjacency[v].neighbors {
+		if it.visited.Add(to) {
+			it.parents[to] = v
+			it.depths[to] = it.depths[v] + 1
+			it.queue.Enqueue(to)
+		}
+	}
+	return v, true
+}
+
+// Depth returns the number of edges on the shortest path from the start to v,
+// or -1 if v has not been discovered yet.
+func (it *BFSIterator[V]) Depth(v V) int {
+	depth, ok := it.depths[v]
+	if !ok {
+		return -1
+	}
+	return depth
+}
+
+// PathTo returns the vertices on a path with the fewest edges from the start to v, including both ends,
+// or nil if v has not been discovered yet.
+func (it *BFSIterator[V]) PathTo(v V) []V {
+	if !it.visited.Contains(v) {
+		return nil
+	}
+	return buildPath(it.parents, v)
+}
+
+// DFSIterator visits the vertices reachable from a start vertex in depth-first preorder.
+type DFSIterator[V comparable] struct {
+	g       *Graph[V]
+	stack   *collections.Stack[dfsEntry[V]]
+	visited *collections.HashSet[V]
+	parents map[V]V
+}
+
+// dfsEntry is a vertex waiting to be visited, with the vertex it was reached from.
+type dfsEntry[V comparable] struct {
+	v, parent V
+	hasParent bool
+}
+
+// DFS creates an iterator over the vertices reachable from start in depth-first preorder.
+// Neighbors are explored in the order their edges were added, as a recursive traversal would.
+// The iterator is empty if start is not present.
+func (g *Graph[V]) DFS(start V) *DFSIterator[V] {
+	it := &DFSIterator[V]{
+		g:       g,
+		stack:   collections.NewStack[dfsEntry[V]](),
+		visited: collections.NewHashSet[V](),
+		parents: make(map[V]V),
+	}
+	if g.HasVertex(start) {
+		it.stack.Push(dfsEntry[V]{v: start})
+	}
+	return it
+}
+
+// skipVisited pops entries for vertices that were visited after being pushed.
+func (it *DFSIterator[V]) skipVisited() {
+	for !it.stack.IsEmpty() && it.visited.Contains(it.stack.Peek().v) {
+		it.stack.Pop()
+	}
+}
+
+// HasNext checks if there are more vertices to visit.
+func (it *DFSIterator[V]) HasNext() bool {
+	it.skipVisited()
+	return !it.stack.IsEmpty()
+}
+
+// Next returns the next vertex.
+// The boolean result is false if every reachable vertex has been visited.
+func (it *DFSIterator[V]) Next() (V, bool) {
+	it.skipVisited()
+	if it.stack.IsEmpty() {
+		var zeroValue V
+		return zeroValue, false
+	}
+	entry := it.stack.Pop()
+	it.visited.Add(entry.v)
+	if entry.hasParent {
+		it.parents[entry.v] = entry.parent
+	}
+
+	// Push in reverse so the first neighbor is explored first.
+	neighbors := it.g.adjacency[entry.v].neighbors
+	for i := len(neighbors) - 1; i >= 0; i-- {
+		if !it.visited.Contains(neighbors[i]) {
+			it.stack.Push(dfsEntry[V]{v: neighbors[i], parent: entry.v, hasParent: true})
+		}
+	}
+	return entry.v, true
+}
+
+// PathTo returns the vertices on the depth-first tree path from the start to v, including both ends,
+// or nil if v has not been visited yet.
+func (it *DFSIterator[V]) PathTo(v V) []V {
+	if !it.visited.Contains(v) {
+		return nil
+	}
+	return buildPath(it.parents, v)
+}
+
+// buildPath follows parents back from v to the vertex without a parent and returns the path in forward order.
+func buildPath[V comparable](parents map[V]V, v V) []V {
+	path := []V{v}
+	for {
+		parent, ok := parents[v]
+		if !ok {
+			break
+		}
+		path = append(path, parent)
+		v = parent
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Path returns a path with the fewest edges from one vertex to another, including both ends.
+// The boolean result is false if to is not reachable from from.
+func (g *Graph[V]) Path(from, to V) ([]V, bool) {
+	it := g.BFS(from)
+	for it.HasNext() {
+		if v, _ := it.Next(); v == to {
+			return it.PathTo(to), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,73 @@
+package graph
+
+import "testing"
+
+func drain[V comparable](it interface{ Next() (V, bool) }) []V {
+	var visited []V
+	for {
+		v, ok := it.Next()
+		if !ok {
+			return visited
+		}
+		visited = append(visited, v)
+	}
+}
+
+// diamond returns a -> b, a -> c, b -> d, c -> d, d -> e.
+func diamond() *Graph[string] {
+	g := NewDirected[string]()
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	g.AddVertex("z")
+	return g
+}
+
+func TestBFS(t *testing.T) {
+	g := diamond()
+	it := g.BFS("a")
+	assertEqual(t, drain[string](it), []string{"a", "b", "c", "d", "e"})
+	if it.HasNext() || it.Depth("e") != 3 || it.Depth("z") != -1 {
+		t.Error("BFS iterator state is unexpected")
+	}
+	assertEqual(t, it.PathTo("e"), []string{"a", "b", "d", "e"})
+	if it.PathTo("z") != nil {
+		t.Error("PathTo(z) != nil")
+	}
+	if g.BFS("missing").HasNext() {
+		t.Error("BFS from a missing vertex is not empty")
+	}
+}
+
+func TestDFS(t *testing.T) {
+	g := diamond()
+	g.AddEdge("b", "e")
+	it := g.DFS("a")
+	assertEqual(t, drain[string](it), []string{"a", "b", "d", "e", "c"})
+	assertEqual(t, it.PathTo("c"), []string{"a", "c"})
+	assertEqual(t, it.PathTo("e"), []string{"a", "b", "d", "e"})
+}
+
+func TestPath(t *testing.T) {
+	g := diamond()
+	if path, ok := g.Path("a", "e"); !ok {
+		t.Error("Path(a, e) = false; want true")
+	} else {
+		assertEqual(t, path, []string{"a", "b", "d", "e"})
+	}
+	if _, ok := g.Path("e", "a"); ok {
+		t.Error("Path(e, a) = true; want false")
+	}
+	assertEqual(t, mustPath(t, g, "a", "a"), []string{"a"})
+}
+
+func mustPath(t *testing.T, g *Graph[string], from, to string) []string {
+	t.Helper()
+	path, ok := g.Path(from, to)
+	if !ok {
+		t.Fatalf("Path(%s, %s) = false; want true", from, to)
+	}
+	return path
+}
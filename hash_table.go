@@ -0,0 +1,332 @@
+package collections
+
+// hashStore is the storage strategy behind Dictionary and HashSet.
+type hashStore[K, V any] interface {
+	get(key K) (V, bool)
+	// set adds or updates key and reports whether it was newly added.
+	set(key K, value V) bool
+	remove(key K) bool
+	len() int
+	clear()
+	// forEach calls fn for every entry until fn returns false.
+	forEach(fn func(key K, value V) bool)
+}
+
+// mapStore is a hashStore backed by a built-in Go map.
+type mapStore[K comparable, V any] struct {
+	items map[K]V
+}
+
+func newMapStore[K comparable, V any]() *mapStore[K, V] {
+	return &mapStore[K, V]{items: make(map[K]V)}
+}
+
+func (s *mapStore[K, V]) get(key K) (V, bool) {
+	value, ok := s.items[key]
+	return value, ok
+}
+
+func (s *mapStore[K, V]) set(key K, value V) bool {
+	_, exists := s.items[key]
+	s.items[key] = value
+	return !exists
+}
+
+func (s *mapStore[K, V]) remove(key K) bool {
+	_, ok := s.items[key]
+	if ok {
+		delete(s.items, key)
+	}
+	return ok
+}
+
+func (s *mapStore[K, V]) len() int {
+	return len(s.items)
+}
+
+func (s *mapStore[K, V]) clear() {
+	s.items = make(map[K]V)
+}
+
+func (s *mapStore[K, V]) forEach(fn func(key K, value V) bool) {
+	for k, v := range s.items {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// chainedTable is a hashStore implemented as a separate-chaining hash table driven by a Hasher.
+// Entries live in one dense slice and each bucket heads a chain threaded through it,
+// so iteration is a linear scan and removal moves the last entry into the hole.
+type chainedTable[K, V any] struct {
+	hasher  Hasher[K]
+	buckets []int
+	entries []chainedEntry[K, V]
+}
+
+type chainedEntry[K, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	next  int
+}
+
+// chainedTableMinBuckets is the bucket count of a chainedTable after its first insert.
+const chainedTableMinBuckets = 8
+
+func newChainedTable[K, V any](hasher Hasher[K]) *chainedTable[K, V] {
+	if hasher == nil {
+		panic("Hasher cannot be nil.")
+	}
+	return &chainedTable[K, V]{hasher: hasher}
+}
+
+func (t *chainedTable[K, V]) find(key K, hash uint64) int {
+	if len(t.buckets) == 0 {
+		return -1
+	}
+	for i := t.buckets[hash&uint64(len(t.buckets)-1)]; i >= 0; i = t.entries[i].next {
+		if t.entries[i].hash == hash && t.hasher.Equal(t.entries[i].key, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *chainedTable[K, V]) get(key K) (V, bool) {
+	if i := t.find(key, t.hasher.Hash(key)); i >= 0 {
+		return t.entries[i].value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+func (t *chainedTable[K, V]) set(key K, value V) bool {
+	hash := t.hasher.Hash(key)
+	if i := t.find(key, hash); i >= 0 {
+		t.entries[i].value = value
+		return false
+	}
+
+	// Keep the load factor at or below 1.
+	if len(t.entries) >= len(t.buckets) {
+		t.rehash(max(chainedTableMinBuckets, 2*len(t.buckets)))
+	}
+	b := hash & uint64(len(t.buckets)-1)
+	t.entries = append(t.entries, chainedEntry[K, V]{key: key, value: value, hash: hash, next: t.buckets[b]})
+	t.buckets[b] = len(t.entries) - 1
+	return true
+}
+
+func (t *chainedTable[K, V]) remove(key K) bool {
+	if len(t.buckets) == 0 {
+		return false
+	}
+
+	hash := t.hasher.Hash(key)
+	link := &t.buckets[hash&uint64(len(t.buckets)-1)]
+	for *link >= 0 {
+		i := *link
+		if t.entries[i].hash == hash && t.hasher.Equal(t.entries[i].key, key) {
+			*link = t.entries[i].next
+			t.fillHole(i)
+			return true
+		}
+		link = &t.entries[i].next
+	}
+	return false
+}
+
+// fillHole moves the last entry into the unlinked slot i and shrinks the entry slice.
+func (t *chainedTable[K, V]) fillHole(i int) {
+	last := len(t.entries) - 1
+	if i != last {
+		link := &t.buckets[t.entries[last].hash&uint64(len(t.buckets)-1)]
+		for *link != last {
+			link = &t.entries[*link].next
+		}
+		*link = i
+		t.entries[i] = t.entries[last]
+	}
+	t.entries[last] = chainedEntry[K, V]{}
+	t.entries = t.entries[:last]
+}
+
+func (t *chainedTable[K, V]) rehash(bucketCount int) {
+	t.buckets = make([]int, bucketCount)
+	for b := range t.buckets {
+		t.buckets[b] = -1
+	}
+	for i := range t.entries {
+		b := t.entries[i].hash & uint64(bucketCount-1)
+		t.entries[i].next = t.buckets[b]
+		t.buckets[b] = i
+	}
+}
+
+func (t *chainedTable[K, V]) len() int {
+	return len(t.entries)
+}
+
+func (t *chainedTable[K, V]) clear() {
+	t.buckets = nil
+	t.entries = nil
+}
+
+func (t *chainedTable[K, V]) forEach(fn func(key K, value V) bool) {
+	for i := range t.entries {
+		if !fn(t.entries[i].key, t.entries[i].value) {
+			return
+		}
+	}
+}
+
+// defaultMaxLoadFactor is the load factor a robinHoodTable grows at when none is given.
+const defaultMaxLoadFactor = 0.85
+
+// robinHoodTable is a hashStore implemented as an open-addressing hash table with Robin Hood
+// linear probing: an inserted entry displaces any resident that sits closer to its home slot,
+// which keeps probe sequences short and lets deletions shift entries back instead of leaving tombstones.
+// Entries are stored inline in a single slice, and iteration follows slot order, so it is
+// deterministic for a deterministic Hasher and sequence of operations.
+type robinHoodTable[K, V any] struct {
+	hasher  Hasher[K]
+	maxLoad float64
+	slots   []robinHoodSlot[K, V]
+	count   int
+}
+
+type robinHoodSlot[K, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	// dist is the probe distance from the entry's home slot plus one; zero marks an empty slot.
+	dist uint32
+}
+
+// robinHoodMinSlots is the slot count of a robinHoodTable after its first insert.
+const robinHoodMinSlots = 8
+
+func newRobinHoodTable[K, V any](hasher Hasher[K], maxLoadFactor float64) *robinHoodTable[K, V] {
+	if hasher == nil {
+		panic("Hasher cannot be nil.")
+	}
+	if maxLoadFactor <= 0 {
+		maxLoadFactor = defaultMaxLoadFactor
+	}
+	if maxLoadFactor >= 1 {
+		panic("Load factor must be less than 1.")
+	}
+	return &robinHoodTable[K, V]{hasher: hasher, maxLoad: maxLoadFactor}
+}
+
+func (t *robinHoodTable[K, V]) find(key K, hash uint64) int {
+	if len(t.slots) == 0 {
+		return -1
+	}
+	mask := uint64(len(t.slots) - 1)
+	i := hash & mask
+	for dist := uint32(1); ; dist++ {
+		slot := &t.slots[i]
+		if slot.dist < dist {
+			// Either an empty slot or an entry closer to home than the key would be: the key is absent.
+			return -1
+		}
+		if slot.hash == hash && t.hasher.Equal(slot.key, key) {
+			return int(i)
+		}
+		i = (i + 1) & mask
+	}
+}
+
+func (t *robinHoodTable[K, V]) get(key K) (V, bool) {
+	if i := t.find(key, t.hasher.Hash(key)); i >= 0 {
+		return t.slots[i].value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+func (t *robinHoodTable[K, V]) set(key K, value V) bool {
+	hash := t.hasher.Hash(key)
+	if i := t.find(key, hash); i >= 0 {
+		t.slots[i].value = value
+		return false
+	}
+
+	if float64(t.count+1) > t.maxLoad*float64(len(t.slots)) {
+		t.resize(max(robinHoodMinSlots, 2*len(t.slots)))
+	}
+	t.insert(robinHoodSlot[K, V]{key: key, value: value, hash: hash, dist: 1})
+	t.count++
+	return true
+}
+
+// insert places an entry known to be absent, displacing residents that are closer to home.
+func (t *robinHoodTable[K, V]) insert(entry robinHoodSlot[K, V]) {
+	mask := uint64(len(t.slots) - 1)
+	i := entry.hash & mask
+	for {
+		slot := &t.slots[i]
+		if slot.dist == 0 {
+			*slot = entry
+			return
+		}
+		if slot.dist < entry.dist {
+			*slot, entry = entry, *slot
+		}
+		i = (i + 1) & mask
+		entry.dist++
+	}
+}
+
+func (t *robinHoodTable[K, V]) remove(key K) bool {
+	i := t.find(key, t.hasher.Hash(key))
+	if i < 0 {
+		return false
+	}
+
+	// Shift the following entries of the probe run back by one slot.
+	mask := len(t.slots) - 1
+	for {
+		next := (i + 1) & mask
+		if t.slots[next].dist <= 1 {
+			break
+		}
+		t.slots[i] = t.slots[next]
+		t.slots[i].dist--
+		i = next
+	}
+	t.slots[i] = robinHoodSlot[K, V]{}
+	t.count--
+	return true
+}
+
+func (t *robinHoodTable[K, V]) resize(slotCount int) {
+	old := t.slots
+	t.slots = make([]robinHoodSlot[K, V], slotCount)
+	for i := range old {
+		if old[i].dist != 0 {
+			old[i].dist = 1
+			t.insert(old[i])
+		}
+	}
+}
+
+func (t *robinHoodTable[K, V]) len() int {
+	return t.count
+}
+
+func (t *robinHoodTable[K, V]) clear() {
+	t.slots = nil
+	t.count = 0
+}
+
+func (t *robinHoodTable[K, V]) forEach(fn func(key K, value V) bool) {
+	for i := range t.slots {
+		if t.slots[i].dist != 0 && !fn(t.slots[i].key, t.slots[i].value) {
+			return
+		}
+	}
+}
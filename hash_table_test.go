@@ -0,0 +1,179 @@
+package collections
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// testHashStore checks a hashStore against a Go map over a random sequence of operations.
+func testHashStore(t *testing.T, store hashStore[string, int]) {
+	t.Helper()
+	r := rand.New(rand.NewSource(11))
+	reference := map[string]int{}
+
+	for i := 0; i < 20000; i++ {
+		key := strconv.Itoa(r.Intn(500))
+		_, existed := reference[key]
+		switch r.Intn(3) {
+		case 0, 1:
+			if added := store.set(key, i); added == existed {
+				t.Fatalf("set(%s) added = %v; want %v", key, added, !existed)
+			}
+			reference[key] = i
+		case 2:
+			if removed := store.remove(key); removed != existed {
+				t.Fatalf("remove(%s) = %v; want %v", key, removed, existed)
+			}
+			delete(reference, key)
+		}
+	}
+
+	if store.len() != len(reference) {
+		t.Fatalf("len() = %d; want %d", store.len(), len(reference))
+	}
+	for key, want := range reference {
+		if got, ok := store.get(key); !ok || got != want {
+			t.Fatalf("get(%s) = %d, %v; want %d, true", key, got, ok, want)
+		}
+	}
+	seen := 0
+	store.forEach(func(key string, value int) bool {
+		if reference[key] != value {
+			t.Fatalf("forEach visited %s = %d; want %d", key, value, reference[key])
+		}
+		seen++
+		return true
+	})
+	if seen != len(reference) {
+		t.Fatalf("forEach visited %d entries; want %d", seen, len(reference))
+	}
+
+	store.clear()
+	if store.len() != 0 {
+		t.Fatalf("len() after clear() = %d; want 0", store.len())
+	}
+}
+
+func TestChainedTable(t *testing.T) {
+	testHashStore(t, newChainedTable[string, int](DefaultComparer[string]()))
+}
+
+func TestRobinHoodTable(t *testing.T) {
+	testHashStore(t, newRobinHoodTable[string, int](DefaultComparer[string](), 0))
+	testHashStore(t, newRobinHoodTable[string, int](DefaultComparer[string](), 0.99))
+}
+
+func TestRobinHoodTable_DeterministicIteration(t *testing.T) {
+	build := func() []int {
+		set := NewHashSetOpenAddressing(DefaultComparerWithSeed[int](42), 0.9)
+		for i := 0; i < 1000; i++ {
+			set.Add(i * 7919)
+		}
+		for i := 0; i < 1000; i += 3 {
+			set.Remove(i * 7919)
+		}
+		return set.Items()
+	}
+
+	first, second := build(), build()
+	assertSliceEqual(t, first, second)
+	if len(first) != 666 {
+		t.Errorf("Items() returned %d items; want 666", len(first))
+	}
+}
+
+func TestDictionaryOpenAddressing(t *testing.T) {
+	dict := NewDictionaryOpenAddressing[string, int](IgnoreCaseComparer(), 0)
+	dict.Set("One", 1)
+	dict.Set("ONE", 11)
+	dict.Set("two", 2)
+
+	if value, ok := dict.Get("one"); !ok || value != 11 {
+		t.Errorf("Get() = %v, %v; want 11, true", value, ok)
+	}
+	if count := dict.Count(); count != 2 {
+		t.Errorf("Count() = %d; want 2", count)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a load factor of 1, but did not panic")
+		}
+	}()
+	NewDictionaryOpenAddressing[string, int](IgnoreCaseComparer(), 1)
+}
+
+const benchmarkSize = 1 << 14
+
+func benchmarkHashSetAdd(b *testing.B, newSet func() *HashSet[int]) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		set := newSet()
+		for j := 0; j < benchmarkSize; j++ {
+			set.Add(j)
+		}
+	}
+}
+
+func benchmarkHashSetContains(b *testing.B, set *HashSet[int]) {
+	for j := 0; j < benchmarkSize; j++ {
+		set.Add(j * 2)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Contains(i % (2 * benchmarkSize))
+	}
+}
+
+func BenchmarkHashSet_Add_Map(b *testing.B) {
+	benchmarkHashSetAdd(b, NewHashSet[int])
+}
+
+func BenchmarkHashSet_Add_Chained(b *testing.B) {
+	benchmarkHashSetAdd(b, func() *HashSet[int] { return NewHashSetWithComparer(DefaultComparer[int]()) })
+}
+
+func BenchmarkHashSet_Add_OpenAddressing(b *testing.B) {
+	benchmarkHashSetAdd(b, func() *HashSet[int] { return NewHashSetOpenAddressing(DefaultComparer[int](), 0) })
+}
+
+func BenchmarkHashSet_Contains_Map(b *testing.B) {
+	benchmarkHashSetContains(b, NewHashSet[int]())
+}
+
+func BenchmarkHashSet_Contains_Chained(b *testing.B) {
+	benchmarkHashSetContains(b, NewHashSetWithComparer(DefaultComparer[int]()))
+}
+
+func BenchmarkHashSet_Contains_OpenAddressing(b *testing.B) {
+	benchmarkHashSetContains(b, NewHashSetOpenAddressing(DefaultComparer[int](), 0))
+}
+
+func benchmarkDictionarySetGet(b *testing.B, newDict func() *Dictionary[string, int]) {
+	keys := make([]string, benchmarkSize)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dict := newDict()
+		for j, key := range keys {
+			dict.Set(key, j)
+		}
+		for _, key := range keys {
+			dict.Get(key)
+		}
+	}
+}
+
+func BenchmarkDictionary_SetGet_Map(b *testing.B) {
+	benchmarkDictionarySetGet(b, NewDictionary[string, int])
+}
+
+func BenchmarkDictionary_SetGet_OpenAddressing(b *testing.B) {
+	benchmarkDictionarySetGet(b, func() *Dictionary[string, int] {
+		return NewDictionaryOpenAddressing[string, int](DefaultComparer[string](), 0)
+	})
+}
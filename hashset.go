@@ -1,59 +1,79 @@
 package collections
 
 // HashSet is a generic type that holds a set of unique items.
-type HashSet[T comparable] struct {
-    items map[T]struct{}
+type HashSet[T any] struct {
+	items hashStore[T, struct{}]
 }
 
 // NewHashSet initializes a new empty HashSet.
 func NewHashSet[T comparable]() *HashSet[T] {
-    return &HashSet[T]{
-        items: make(map[T]struct{}),
-    }
+	return &HashSet[T]{
+		items: newMapStore[T, struct{}](),
+	}
+}
+
+// NewHashSetWithComparer initializes a new empty HashSet that uses hasher to compare and hash items.
+// The item type does not need to be comparable, so slices, maps and structs holding them can be stored.
+//
+// Example:
+//  set := NewHashSetWithComparer(IgnoreCaseComparer())
+//  set.Add("Go")
+//  fmt.Println(set.Contains("GO")) // Output: true
+func NewHashSetWithComparer[T any](hasher Hasher[T]) *HashSet[T] {
+	return &HashSet[T]{
+		items: newChainedTable[T, struct{}](hasher),
+	}
+}
+
+// NewHashSetOpenAddressing initializes a new empty HashSet backed by an open-addressing
+// (Robin Hood) hash table instead of a Go map. Items are stored inline without per-item buckets,
+// the table grows once it is more than maxLoadFactor full (a value of 0 selects 0.85),
+// and Items returns items in an order that is deterministic for a seeded hasher.
+//
+// Example:
+//  set := NewHashSetOpenAddressing(DefaultComparerWithSeed[int](42), 0.9)
+//  set.Add(1)
+func NewHashSetOpenAddressing[T any](hasher Hasher[T], maxLoadFactor float64) *HashSet[T] {
+	return &HashSet[T]{
+		items: newRobinHoodTable[T, struct{}](hasher, maxLoadFactor),
+	}
 }
 
 // Add adds an item to the HashSet.
 // Returns true if the item was added, false if it was already present.
 func (s *HashSet[T]) Add(item T) bool {
-    if _, exists := s.items[item]; exists {
-        return false
-    }
-    s.items[item] = struct{}{}
-    return true
+	return s.items.set(item, struct{}{})
 }
 
 // Remove removes an item from the HashSet.
 // Returns true if the item was removed, false if it was not present.
 func (s *HashSet[T]) Remove(item T) bool {
-    if _, exists := s.items[item]; !exists {
-        return false
-    }
-    delete(s.items, item)
-    return true
+	return s.items.remove(item)
 }
 
 // Contains checks if an item is present in the HashSet.
 // Returns true if the item is present, false otherwise.
 func (s *HashSet[T]) Contains(item T) bool {
-    _, exists := s.items[item]
-    return exists
+	_, exists := s.items.get(item)
+	return exists
 }
 
 // Count returns the number of items in the HashSet.
 func (s *HashSet[T]) Count() int {
-    return len(s.items)
+	return s.items.len()
 }
 
 // Clear removes all items from the HashSet.
 func (s *HashSet[T]) Clear() {
-    s.items = make(map[T]struct{})
+	s.items.clear()
 }
 
 // Items returns a slice of all items in the HashSet.
 func (s *HashSet[T]) Items() []T {
-    keys := make([]T, 0, len(s.items))
-    for k := range s.items {
-        keys = append(keys, k)
-    }
-    return keys
+	keys := make([]T, 0, s.items.len())
+	s.items.forEach(func(k T, _ struct{}) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
 }
@@ -0,0 +1,303 @@
+package collections
+
+import (
+	"cmp"
+	"math/rand/v2"
+)
+
+// Interval is a range of ordered keys from Lo to Hi. Each endpoint is included unless
+// the matching Open flag is set.
+type Interval[K any] struct {
+	Lo, Hi         K
+	LoOpen, HiOpen bool
+}
+
+// ClosedInterval returns the interval [lo, hi].
+func ClosedInterval[K any](lo, hi K) Interval[K] {
+	return Interval[K]{Lo: lo, Hi: hi}
+}
+
+// OpenInterval returns the interval (lo, hi).
+func OpenInterval[K any](lo, hi K) Interval[K] {
+	return Interval[K]{Lo: lo, Hi: hi, LoOpen: true, HiOpen: true}
+}
+
+// ClosedOpenInterval returns the interval [lo, hi).
+func ClosedOpenInterval[K any](lo, hi K) Interval[K] {
+	return Interval[K]{Lo: lo, Hi: hi, HiOpen: true}
+}
+
+// OpenClosedInterval returns the interval (lo, hi].
+func OpenClosedInterval[K any](lo, hi K) Interval[K] {
+	return Interval[K]{Lo: lo, Hi: hi, LoOpen: true}
+}
+
+// IntervalEntry is an interval stored in an IntervalTree together with its value.
+type IntervalEntry[K any, V any] struct {
+	Interval Interval[K]
+	Value    V
+}
+
+// IntervalTree stores intervals over ordered keys with associated values and finds the intervals
+// that contain a point or overlap a range in O(log n + m) expected time for m results.
+// The same interval may be stored several times with different values.
+// It is a treap ordered by lower endpoint in which every node records the largest upper endpoint below it.
+//
+// Example:
+//  bookings := NewIntervalTree[int, string]()
+//  bookings.Insert(ClosedOpenInterval(9, 11), "standup")
+//  bookings.Insert(ClosedOpenInterval(10, 12), "review")
+//  for _, e := range bookings.Stabbing(10) {
+//  	fmt.Println(e.Value)
+//  }
+//  // Output:
+//  // standup
+//  // review
+type IntervalTree[K any, V any] struct {
+	root    *intervalNode[K, V]
+	compare func(a, b K) int
+	size    int
+	// seq orders duplicate intervals by insertion.
+	seq uint64
+}
+
+type intervalNode[K any, V any] struct {
+	entry    IntervalEntry[K, V]
+	seq      uint64
+	priority uint64
+	// maxHi is the largest upper endpoint in the node's subtree.
+	maxHi       Interval[K]
+	left, right *intervalNode[K, V]
+}
+
+// NewIntervalTree creates a new empty IntervalTree over the natural order of K.
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return NewIntervalTreeFunc[K, V](cmp.Compare[K])
+}
+
+// NewIntervalTreeFunc creates a new empty IntervalTree over keys ordered by compare.
+func NewIntervalTreeFunc[K any, V any](compare func(a, b K) int) *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{compare: compare}
+}
+
+// startsBeforeEnd reports whether a lower endpoint lies at or before an upper endpoint,
+// that is, whether a point can be above the first and below the second.
+func (t *IntervalTree[K, V]) startsBeforeEnd(lo K, loOpen bool, hi K, hiOpen bool) bool {
+	c := t.compare(lo, hi)
+	return c < 0 || (c == 0 && !loOpen && !hiOpen)
+}
+
+// compareLo orders lower endpoints; a closed endpoint starts before an open one at the same key.
+func (t *IntervalTree[K, V]) compareLo(a, b Interval[K]) int {
+	if c := t.compare(a.Lo, b.Lo); c != 0 {
+		return c
+	}
+	switch {
+	case a.LoOpen == b.LoOpen:
+		return 0
+	case a.LoOpen:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// compareHi orders upper endpoints; an open endpoint ends before a closed one at the same key.
+func (t *IntervalTree[K, V]) compareHi(a, b Interval[K]) int {
+	if c := t.compare(a.Hi, b.Hi); c != 0 {
+		return c
+	}
+	switch {
+	case a.HiOpen == b.HiOpen:
+		return 0
+	case a.HiOpen:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareIntervals orders intervals by lower endpoint and then by upper endpoint.
+func (t *IntervalTree[K, V]) compareIntervals(a, b Interval[K]) int {
+	if c := t.compareLo(a, b); c != 0 {
+		return c
+	}
+	return t.compareHi(a, b)
+}
+
+// nodeBefore reports whether node a sorts before node b, breaking ties between equal intervals by insertion.
+func (t *IntervalTree[K, V]) nodeBefore(a, b *intervalNode[K, V]) bool {
+	if c := t.compareIntervals(a.entry.Interval, b.entry.Interval); c != 0 {
+		return c < 0
+	}
+	return a.seq < b.seq
+}
+
+func (t *IntervalTree[K, V]) update(n *intervalNode[K, V]) {
+	n.maxHi = n.entry.Interval
+	for _, child := range [2]*intervalNode[K, V]{n.left, n.right} {
+		if child != nil && t.compareHi(child.maxHi, n.maxHi) > 0 {
+			n.maxHi = child.maxHi
+		}
+	}
+}
+
+// split divides the treap n into the nodes sorting before pivot and the rest.
+func (t *IntervalTree[K, V]) split(n, pivot *intervalNode[K, V]) (*intervalNode[K, V], *intervalNode[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if t.nodeBefore(n, pivot) {
+		left, right := t.split(n.right, pivot)
+		n.right = left
+		t.update(n)
+		return n, right
+	}
+	left, right := t.split(n.left, pivot)
+	n.left = right
+	t.update(n)
+	return left, n
+}
+
+// merge joins the treaps a and b, where every node in a sorts before every node in b.
+func (t *IntervalTree[K, V]) merge(a, b *intervalNode[K, V]) *intervalNode[K, V] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = t.merge(a.right, b)
+		t.update(a)
+		return a
+	}
+	b.left = t.merge(a, b.left)
+	t.update(b)
+	return b
+}
+
+// Insert adds an interval with its value. An interval that is already present is stored again.
+// It panics if the interval is empty.
+func (t *IntervalTree[K, V]) Insert(iv Interval[K], value V) {
+	if !t.startsBeforeEnd(iv.Lo, iv.LoOpen, iv.Hi, iv.HiOpen) {
+		panic("Interval must not be empty.")
+	}
+	t.seq++
+	n := &intervalNode[K, V]{
+		entry:    IntervalEntry[K, V]{Interval: iv, Value: value},
+		seq:      t.seq,
+		priority: rand.Uint64(),
+		maxHi:    iv,
+	}
+	left, right := t.split(t.root, n)
+	t.root = t.merge(t.merge(left, n), right)
+	t.size++
+}
+
+// Delete removes the earliest inserted entry with exactly the interval iv whose value satisfies match,
+// or with any value if match is nil.
+// Returns true if an entry was removed.
+//
+// Example:
+//  tree.Delete(ClosedInterval(1, 5), func(v string) bool { return v == "b" })
+func (t *IntervalTree[K, V]) Delete(iv Interval[K], match func(value V) bool) bool {
+	root, deleted := t.delete(t.root, iv, match)
+	if deleted {
+		t.root = root
+		t.size--
+	}
+	return deleted
+}
+
+func (t *IntervalTree[K, V]) delete(n *intervalNode[K, V], iv Interval[K], match func(value V) bool) (*intervalNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	c := t.compareIntervals(iv, n.entry.Interval)
+	deleted := false
+	if c <= 0 {
+		n.left, deleted = t.delete(n.left, iv, match)
+	}
+	if !deleted && c == 0 && (match == nil || match(n.entry.Value)) {
+		return t.merge(n.left, n.right), true
+	}
+	if !deleted && c >= 0 {
+		n.right, deleted = t.delete(n.right, iv, match)
+	}
+	if deleted {
+		t.update(n)
+	}
+	return n, deleted
+}
+
+// Stabbing returns the entries whose intervals contain point, in interval order.
+func (t *IntervalTree[K, V]) Stabbing(point K) []IntervalEntry[K, V] {
+	return t.OverlappingInterval(ClosedInterval(point, point))
+}
+
+// Overlapping returns the entries whose intervals overlap the closed range [lo, hi], in interval order.
+func (t *IntervalTree[K, V]) Overlapping(lo, hi K) []IntervalEntry[K, V] {
+	return t.OverlappingInterval(ClosedInterval(lo, hi))
+}
+
+// OverlappingInterval returns the entries whose intervals share at least one point with query,
+// in interval order.
+func (t *IntervalTree[K, V]) OverlappingInterval(query Interval[K]) []IntervalEntry[K, V] {
+	var entries []IntervalEntry[K, V]
+	t.overlapping(t.root, query, &entries)
+	return entries
+}
+
+func (t *IntervalTree[K, V]) overlapping(n *intervalNode[K, V], query Interval[K], entries *[]IntervalEntry[K, V]) {
+	// Skip subtrees that end before the query starts.
+	if n == nil || !t.startsBeforeEnd(query.Lo, query.LoOpen, n.maxHi.Hi, n.maxHi.HiOpen) {
+		return
+	}
+	t.overlapping(n.left, query, entries)
+
+	iv := n.entry.Interval
+	if !t.startsBeforeEnd(iv.Lo, iv.LoOpen, query.Hi, query.HiOpen) {
+		// This interval, and everything after it, starts after the query ends.
+		return
+	}
+	if t.startsBeforeEnd(query.Lo, query.LoOpen, iv.Hi, iv.HiOpen) {
+		*entries = append(*entries, n.entry)
+	}
+	t.overlapping(n.right, query, entries)
+}
+
+// Count returns the number of entries in the tree.
+func (t *IntervalTree[K, V]) Count() int {
+	return t.size
+}
+
+// Clear removes all entries from the tree.
+func (t *IntervalTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Ascend calls fn for every entry in interval order until fn returns false.
+// Intervals are ordered by lower endpoint, then by upper endpoint, then by insertion.
+func (t *IntervalTree[K, V]) Ascend(fn func(iv Interval[K], value V) bool) {
+	ascendIntervals(t.root, fn)
+}
+
+func ascendIntervals[K any, V any](n *intervalNode[K, V], fn func(iv Interval[K], value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return ascendIntervals(n.left, fn) && fn(n.entry.Interval, n.entry.Value) && ascendIntervals(n.right, fn)
+}
+
+// Entries returns a slice of all entries in interval order.
+func (t *IntervalTree[K, V]) Entries() []IntervalEntry[K, V] {
+	entries := make([]IntervalEntry[K, V], 0, t.size)
+	t.Ascend(func(iv Interval[K], value V) bool {
+		entries = append(entries, IntervalEntry[K, V]{Interval: iv, Value: value})
+		return true
+	})
+	return entries
+}
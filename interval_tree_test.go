@@ -0,0 +1,108 @@
+package collections
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func intervalValues[K any, V any](entries []IntervalEntry[K, V]) []V {
+	values := make([]V, len(entries))
+	for i, e := range entries {
+		values[i] = e.Value
+	}
+	return values
+}
+
+func TestIntervalTree(t *testing.T) {
+	tree := NewIntervalTree[int, string]()
+	tree.Insert(ClosedInterval(1, 5), "a")
+	tree.Insert(ClosedOpenInterval(5, 8), "b")
+	tree.Insert(OpenInterval(8, 10), "c")
+	tree.Insert(OpenClosedInterval(0, 1), "d")
+	tree.Insert(ClosedInterval(1, 5), "e")
+
+	assertSliceEqual(t, intervalValues(tree.Stabbing(1)), []string{"d", "a", "e"})
+	assertSliceEqual(t, intervalValues(tree.Stabbing(5)), []string{"a", "e", "b"})
+	assertSliceEqual(t, intervalValues(tree.Stabbing(8)), []string{})
+	assertSliceEqual(t, intervalValues(tree.Stabbing(9)), []string{"c"})
+	assertSliceEqual(t, intervalValues(tree.Overlapping(6, 8)), []string{"b"})
+	assertSliceEqual(t, intervalValues(tree.OverlappingInterval(OpenInterval(5, 9))), []string{"b", "c"})
+	assertSliceEqual(t, intervalValues(tree.OverlappingInterval(OpenInterval(-1, 0))), []string{})
+
+	var order []string
+	tree.Ascend(func(_ Interval[int], v string) bool {
+		order = append(order, v)
+		return true
+	})
+	assertSliceEqual(t, order, []string{"d", "a", "e", "b", "c"})
+
+	if !tree.Delete(ClosedInterval(1, 5), func(v string) bool { return v == "e" }) {
+		t.Error("Delete([1, 5], e) = false; want true")
+	}
+	if tree.Delete(ClosedOpenInterval(1, 5), nil) {
+		t.Error("Delete([1, 5)) = true for an interval that was never inserted")
+	}
+	if !tree.Delete(ClosedInterval(1, 5), nil) || tree.Count() != 3 {
+		t.Errorf("Delete([1, 5]) left %d entries; want 3", tree.Count())
+	}
+	assertSliceEqual(t, intervalValues(tree.Entries()), []string{"d", "b", "c"})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for an empty interval, but did not panic")
+		}
+	}()
+	tree.Insert(ClosedOpenInterval(3, 3), "empty")
+}
+
+func TestIntervalTree_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+	tree := NewIntervalTree[int, int]()
+	var reference []IntervalEntry[int, int]
+
+	contains := func(iv Interval[int], p int) bool {
+		above := p > iv.Lo || (p == iv.Lo && !iv.LoOpen)
+		below := p < iv.Hi || (p == iv.Hi && !iv.HiOpen)
+		return above && below
+	}
+	randomInterval := func() Interval[int] {
+		lo := r.Intn(100)
+		return Interval[int]{Lo: lo, Hi: lo + 1 + r.Intn(20), LoOpen: r.Intn(2) == 0, HiOpen: r.Intn(2) == 0}
+	}
+
+	for i := 0; i < 3000; i++ {
+		if r.Intn(3) == 0 && len(reference) > 0 {
+			j := r.Intn(len(reference))
+			target := reference[j]
+			if !tree.Delete(target.Interval, func(v int) bool { return v == target.Value }) {
+				t.Fatalf("Delete(%v, %d) = false", target.Interval, target.Value)
+			}
+			reference = append(reference[:j], reference[j+1:]...)
+		} else {
+			iv := randomInterval()
+			tree.Insert(iv, i)
+			reference = append(reference, IntervalEntry[int, int]{Interval: iv, Value: i})
+		}
+	}
+
+	if tree.Count() != len(reference) {
+		t.Fatalf("Count() = %d; want %d", tree.Count(), len(reference))
+	}
+	for p := -1; p <= 121; p++ {
+		want := map[int]bool{}
+		for _, e := range reference {
+			if contains(e.Interval, p) {
+				want[e.Value] = true
+			}
+		}
+		got := tree.Stabbing(p)
+		if len(got) != len(want) {
+			t.Fatalf("Stabbing(%d) returned %d entries; want %d", p, len(got), len(want))
+		}
+		for _, e := range got {
+			if !want[e.Value] {
+				t.Fatalf("Stabbing(%d) returned %v, which does not contain it", p, e.Interval)
+			}
+		}
+	}
+}
@@ -1,52 +1,120 @@
 package collections
 
 // Node represents a node in the linked list.
-type Node[T comparable] struct {
+type Node[T any] struct {
 	Value T
 	Next  *Node[T]
 }
 
 // LinkedList represents a generic singly linked list.
-type LinkedList[T comparable] struct {
-	head *Node[T]
-	size int
+type LinkedList[T any] struct {
+	head     *Node[T]
+	tail     *Node[T]
+	size     int
+	comparer EqualityComparer[T]
 }
 
 // NewLinkedList creates a new instance of a LinkedList.
 func NewLinkedList[T comparable]() *LinkedList[T] {
-	return &LinkedList[T]{}
+	return &LinkedList[T]{comparer: DefaultComparer[T]()}
 }
 
-// Add adds an element to the end of the linked list.
+// NewLinkedListWithComparer creates a new instance of a LinkedList that compares values with comparer.
+// If comparer is also a Hasher, RemoveDuplicates runs in linear time.
+func NewLinkedListWithComparer[T any](comparer EqualityComparer[T]) *LinkedList[T] {
+	return &LinkedList[T]{comparer: comparer}
+}
+
+// Add adds an element to the end of the linked list in O(1).
 func (ll *LinkedList[T]) Add(value T) {
 	newNode := &Node[T]{Value: value}
 
 	if ll.head == nil {
 		ll.head = newNode
 	} else {
-		current := ll.head
-		for current.Next != nil {
-			current = current.Next
-		}
-		current.Next = newNode
+		ll.tail.Next = newNode
+	}
+	ll.tail = newNode
+	ll.size++
+}
+
+// AddFirst adds an element to the beginning of the linked list in O(1).
+func (ll *LinkedList[T]) AddFirst(value T) {
+	ll.head = &Node[T]{Value: value, Next: ll.head}
+	if ll.tail == nil {
+		ll.tail = ll.head
 	}
 	ll.size++
 }
 
+// InsertAt inserts an element at the specified index, shifting later elements back.
+// An index equal to Size appends the element.
+func (ll *LinkedList[T]) InsertAt(index int, value T) {
+	if index < 0 || index > ll.size {
+		panic("index out of bounds")
+	}
+
+	switch index {
+	case 0:
+		ll.AddFirst(value)
+	case ll.size:
+		ll.Add(value)
+	default:
+		prev := ll.nodeAt(index - 1)
+		prev.Next = &Node[T]{Value: value, Next: prev.Next}
+		ll.size++
+	}
+}
+
+// RemoveFirst removes and returns the first element of the linked list.
+// It panics if the list is empty.
+func (ll *LinkedList[T]) RemoveFirst() T {
+	if ll.head == nil {
+		panic("RemoveFirst from an empty linked list")
+	}
+
+	value := ll.head.Value
+	ll.head = ll.head.Next
+	if ll.head == nil {
+		ll.tail = nil
+	}
+	ll.size--
+	return value
+}
+
+// RemoveAt removes and returns the element at the specified index.
+func (ll *LinkedList[T]) RemoveAt(index int) T {
+	if index < 0 || index >= ll.size {
+		panic("index out of bounds")
+	}
+
+	if index == 0 {
+		return ll.RemoveFirst()
+	}
+
+	prev := ll.nodeAt(index - 1)
+	removed := prev.Next
+	prev.Next = removed.Next
+	if removed == ll.tail {
+		ll.tail = prev
+	}
+	ll.size--
+	return removed.Value
+}
+
 // Remove removes the first occurrence of the specified value from the linked list.
 func (ll *LinkedList[T]) Remove(value T) bool {
 	if ll.head == nil {
 		return false
 	}
 
-	if ll.head.Value == value {
-		ll.head = ll.head.Next
-		ll.size--
+	if equalValues(ll.comparer, ll.head.Value, value) {
+		ll.RemoveFirst()
 		return true
 	}
 
 	current := ll.head
-	for current.Next != nil && current.Next.Value != value {
+	for current.Next != nil && !equalValues(ll.comparer, current.Next.Value, value) {
 		current = current.Next
 	}
 
@@ -54,6 +122,9 @@ func (ll *LinkedList[T]) Remove(value T) bool {
 		return false
 	}
 
+	if current.Next == ll.tail {
+		ll.tail = current
+	}
 	current.Next = current.Next.Next
 	ll.size--
 	return true
@@ -61,14 +132,19 @@ func (ll *LinkedList[T]) Remove(value T) bool {
 
 // Contains checks if the linked list contains the specified value.
 func (ll *LinkedList[T]) Contains(value T) bool {
-	current := ll.head
-	for current != nil {
-		if current.Value == value {
-			return true
+	return ll.IndexOf(value) >= 0
+}
+
+// IndexOf returns the index of the first occurrence of the specified value, or -1 if it is not found.
+func (ll *LinkedList[T]) IndexOf(value T) int {
+	index := 0
+	for current := ll.head; current != nil; current = current.Next {
+		if equalValues(ll.comparer, current.Value, value) {
+			return index
 		}
-		current = current.Next
+		index++
 	}
-	return false
+	return -1
 }
 
 // Size returns the number of elements in the linked list.
@@ -82,12 +158,91 @@ func (ll *LinkedList[T]) Get(index int) T {
 		panic("index out of bounds")
 	}
 
+	return ll.nodeAt(index).Value
+}
+
+// Clear removes all elements from the linked list.
+func (ll *LinkedList[T]) Clear() {
+	ll.head = nil
+	ll.tail = nil
+	ll.size = 0
+}
+
+// Reverse reverses the order of the elements in place.
+func (ll *LinkedList[T]) Reverse() {
+	var prev *Node[T]
 	current := ll.head
-	for i := 0; i < index; i++ {
+	ll.tail = ll.head
+	for current != nil {
+		next := current.Next
+		current.Next = prev
+		prev = current
+		current = next
+	}
+	ll.head = prev
+}
+
+// Sort sorts the linked list in place using a stable merge sort ordered by less.
+// It runs in O(n log n) time without allocating.
+func (ll *LinkedList[T]) Sort(less func(a, b T) bool) {
+	ll.head = mergeSortNodes(ll.head, less)
+	ll.tail = ll.head
+	for ll.tail != nil && ll.tail.Next != nil {
+		ll.tail = ll.tail.Next
+	}
+}
+
+// RemoveDuplicates removes every element equal to an earlier element, keeping first occurrences.
+// Returns the number of elements removed. It runs in O(n) when the list's comparer is a Hasher
+// and in O(n^2) otherwise.
+func (ll *LinkedList[T]) RemoveDuplicates() int {
+	if ll.head == nil {
+		return 0
+	}
+
+	seen := func(value T, upTo *Node[T]) bool {
+		for n := ll.head; n != upTo.Next; n = n.Next {
+			if equalValues(ll.comparer, n.Value, value) {
+				return true
+			}
+		}
+		return false
+	}
+	if hasher, ok := ll.comparer.(Hasher[T]); ok {
+		set := NewHashSetWithComparer(hasher)
+		set.Add(ll.head.Value)
+		seen = func(value T, _ *Node[T]) bool {
+			return !set.Add(value)
+		}
+	}
+
+	removed := 0
+	current := ll.head
+	for current.Next != nil {
+		if seen(current.Next.Value, current) {
+			current.Next = current.Next.Next
+			removed++
+			continue
+		}
 		current = current.Next
 	}
+	ll.tail = current
+	ll.size -= removed
+	return removed
+}
 
-	return current.Value
+// HasCycle reports whether following Next pointers from the head ever revisits a node,
+// using Floyd's tortoise and hare algorithm. A cycle can only appear if nodes were linked by hand.
+func (ll *LinkedList[T]) HasCycle() bool {
+	slow, fast := ll.head, ll.head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return true
+		}
+	}
+	return false
 }
 
 // ToSlice converts the linked list to a slice.
@@ -100,3 +255,78 @@ func (ll *LinkedList[T]) ToSlice() []T {
 	}
 	return slice
 }
+
+func (ll *LinkedList[T]) nodeAt(index int) *Node[T] {
+	if index == ll.size-1 {
+		return ll.tail
+	}
+
+	current := ll.head
+	for i := 0; i < index; i++ {
+		current = current.Next
+	}
+	return current
+}
+
+func mergeSortNodes[T any](head *Node[T], less func(a, b T) bool) *Node[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+
+	// Split the list in half using a slow and a fast pointer.
+	slow, fast := head, head.Next
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	second := slow.Next
+	slow.Next = nil
+
+	left := mergeSortNodes(head, less)
+	right := mergeSortNodes(second, less)
+
+	var dummy Node[T]
+	tail := &dummy
+	for left != nil && right != nil {
+		if less(right.Value, left.Value) {
+			tail.Next = right
+			right = right.Next
+		} else {
+			tail.Next = left
+			left = left.Next
+		}
+		tail = tail.Next
+	}
+	if left != nil {
+		tail.Next = left
+	} else {
+		tail.Next = right
+	}
+	return dummy.Next
+}
+
+// LinkedListIterator represents an iterator for the LinkedList.
+type LinkedListIterator[T any] struct {
+	current *Node[T]
+}
+
+// NewIterator creates a new iterator for the LinkedList.
+func (ll *LinkedList[T]) NewIterator() *LinkedListIterator[T] {
+	return &LinkedListIterator[T]{current: ll.head}
+}
+
+// HasNext checks if there are more elements to iterate over.
+func (it *LinkedListIterator[T]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next returns the next element in the iteration.
+func (it *LinkedListIterator[T]) Next() (T, bool) {
+	if it.HasNext() {
+		item := it.current.Value
+		it.current = it.current.Next
+		return item, true
+	}
+	var zeroValue T
+	return zeroValue, false
+}
@@ -0,0 +1,147 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestLinkedList(t *testing.T) {
+	ll := NewLinkedList[int]()
+	ll.Add(2)
+	ll.Add(3)
+	ll.AddFirst(1)
+	ll.InsertAt(3, 5)
+	ll.InsertAt(3, 4)
+	ll.InsertAt(0, 0)
+
+	assertSliceEqual(t, ll.ToSlice(), []int{0, 1, 2, 3, 4, 5})
+	if size := ll.Size(); size != 6 {
+		t.Errorf("Size() = %d; want 6", size)
+	}
+	if v := ll.Get(5); v != 5 {
+		t.Errorf("Get(5) = %d; want 5", v)
+	}
+	if i := ll.IndexOf(3); i != 3 {
+		t.Errorf("IndexOf(3) = %d; want 3", i)
+	}
+	if i := ll.IndexOf(9); i != -1 {
+		t.Errorf("IndexOf(9) = %d; want -1", i)
+	}
+
+	if v := ll.RemoveFirst(); v != 0 {
+		t.Errorf("RemoveFirst() = %d; want 0", v)
+	}
+	if v := ll.RemoveAt(4); v != 5 {
+		t.Errorf("RemoveAt(4) = %d; want 5", v)
+	}
+	if !ll.Remove(4) {
+		t.Error("Remove(4) = false; want true")
+	}
+
+	// The tail must still be correct after removing from the end.
+	ll.Add(6)
+	assertSliceEqual(t, ll.ToSlice(), []int{1, 2, 3, 6})
+
+	ll.Reverse()
+	assertSliceEqual(t, ll.ToSlice(), []int{6, 3, 2, 1})
+	ll.Add(0)
+	assertSliceEqual(t, ll.ToSlice(), []int{6, 3, 2, 1, 0})
+
+	ll.Clear()
+	if ll.Size() != 0 || len(ll.ToSlice()) != 0 {
+		t.Errorf("Clear() left %v", ll.ToSlice())
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when removing from an empty list, but did not panic")
+		}
+	}()
+	ll.RemoveFirst()
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	type pair struct{ key, order int }
+	r := rand.New(rand.NewSource(5))
+	ll := NewLinkedList[pair]()
+	var reference []pair
+	for i := 0; i < 500; i++ {
+		p := pair{key: r.Intn(50), order: i}
+		ll.Add(p)
+		reference = append(reference, p)
+	}
+
+	less := func(a, b pair) bool { return a.key < b.key }
+	ll.Sort(less)
+	sort.SliceStable(reference, func(i, j int) bool { return less(reference[i], reference[j]) })
+
+	assertSliceEqual(t, ll.ToSlice(), reference)
+	ll.Add(pair{key: -1})
+	if last := ll.Get(ll.Size() - 1); last.key != -1 {
+		t.Errorf("Add() after Sort() appended at the wrong place: %v", last)
+	}
+}
+
+func TestLinkedList_RemoveDuplicates(t *testing.T) {
+	ll := NewLinkedList[string]()
+	for _, s := range []string{"a", "b", "a", "c", "b", "c"} {
+		ll.Add(s)
+	}
+
+	if removed := ll.RemoveDuplicates(); removed != 3 {
+		t.Errorf("RemoveDuplicates() = %d; want 3", removed)
+	}
+	assertSliceEqual(t, ll.ToSlice(), []string{"a", "b", "c"})
+	ll.Add("d")
+	assertSliceEqual(t, ll.ToSlice(), []string{"a", "b", "c", "d"})
+}
+
+func TestLinkedList_HasCycle(t *testing.T) {
+	ll := NewLinkedList[int]()
+	if ll.HasCycle() {
+		t.Error("HasCycle() on an empty list = true; want false")
+	}
+	for i := 0; i < 5; i++ {
+		ll.Add(i)
+		if ll.HasCycle() {
+			t.Errorf("HasCycle() with %d items = true; want false", ll.Size())
+		}
+	}
+
+	// Link the last node back to the second one.
+	ll.nodeAt(4).Next = ll.nodeAt(1)
+	if !ll.HasCycle() {
+		t.Error("HasCycle() = false; want true")
+	}
+
+	// A single node linked to itself is a cycle too.
+	single := NewLinkedList[int]()
+	single.Add(1)
+	single.nodeAt(0).Next = single.nodeAt(0)
+	if !single.HasCycle() {
+		t.Error("HasCycle() with a self-loop = false; want true")
+	}
+}
+
+func TestLinkedList_Iterator(t *testing.T) {
+	ll := NewLinkedList[int]()
+	for i := 1; i <= 3; i++ {
+		ll.Add(i)
+	}
+
+	var result []int
+	it := ll.NewIterator()
+	for it.HasNext() {
+		item, ok := it.Next()
+		if !ok {
+			t.Fatalf("Expected more items but found none")
+		}
+		result = append(result, item)
+	}
+	assertSliceEqual(t, result, []int{1, 2, 3})
+
+	if _, ok := it.Next(); ok {
+		t.Error("Next() after exhaustion returned true; want false")
+	}
+}
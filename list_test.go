@@ -272,3 +272,87 @@ func TestListIterator(t *testing.T) {
 		t.Errorf("Expected Next() to return false for empty list, but it returned %v", item)
 	}
 }
+
+// sharedList is the behaviour List and ArrayList have in common.
+type sharedList interface {
+	Count() int
+	Clear()
+	Items() []int
+	Add(item int)
+	AddRange(items []int)
+	Get(index int) int
+	Set(index int, item int)
+	IndexOf(item int) int
+	Contains(item int) bool
+	RemoveAt(index int)
+	OrderBy(less func(i, j int) bool)
+	Filter(predicate func(int) bool) []int
+	First(predicate func(int) bool) int
+	FirstOrDefault(predicate func(int) bool) int
+	LastOrDefault(predicate func(int) bool) int
+	Single(predicate func(int) bool) int
+	SingleOrDefault(predicate func(int) bool) int
+}
+
+// testSharedList runs the common List and ArrayList behaviour against a list built by newList.
+func testSharedList(t *testing.T, newList func(items ...int) sharedList) {
+	list := newList(5, 3, 1)
+	list.Add(4)
+	list.AddRange([]int{2})
+	assertSliceEqual(t, list.Items(), []int{5, 3, 1, 4, 2})
+
+	list.OrderBy(func(i, j int) bool { return i < j })
+	assertSliceEqual(t, list.Items(), []int{1, 2, 3, 4, 5})
+
+	if i := list.IndexOf(4); i != 3 {
+		t.Errorf("IndexOf(4) = %d; want 3", i)
+	}
+	if list.Contains(9) {
+		t.Error("Contains(9) = true; want false")
+	}
+
+	list.Set(0, 10)
+	if v := list.Get(0); v != 10 {
+		t.Errorf("Get(0) = %d; want 10", v)
+	}
+	list.RemoveAt(0)
+	assertSliceEqual(t, list.Items(), []int{2, 3, 4, 5})
+
+	even := func(item int) bool { return item%2 == 0 }
+	assertSliceEqual(t, list.Filter(even), []int{2, 4})
+	if v := list.First(even); v != 2 {
+		t.Errorf("First() = %d; want 2", v)
+	}
+	if v := list.FirstOrDefault(func(item int) bool { return item > 10 }); v != 0 {
+		t.Errorf("FirstOrDefault() = %d; want 0", v)
+	}
+	if v := list.LastOrDefault(even); v != 4 {
+		t.Errorf("LastOrDefault() = %d; want 4", v)
+	}
+	if v := list.Single(func(item int) bool { return item == 3 }); v != 3 {
+		t.Errorf("Single() = %d; want 3", v)
+	}
+	if v := list.SingleOrDefault(func(item int) bool { return item == 7 }); v != 0 {
+		t.Errorf("SingleOrDefault() = %d; want 0", v)
+	}
+
+	list.Clear()
+	if count := list.Count(); count != 0 {
+		t.Errorf("Count() after Clear() = %d; want 0", count)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a sequence with no element, but did not panic")
+		}
+	}()
+	list.First(even)
+}
+
+func TestList_SharedBehaviour(t *testing.T) {
+	testSharedList(t, func(items ...int) sharedList { return NewListT(items...) })
+}
+
+func TestArrayList_SharedBehaviour(t *testing.T) {
+	testSharedList(t, func(items ...int) sharedList { return NewArrayListT(items...) })
+}
@@ -0,0 +1,290 @@
+package collections
+
+import "math/bits"
+
+// MinMaxHeap is a generic double-ended priority queue backed by a min-max heap.
+// Both the minimum and the maximum, as defined by less, can be read in O(1) and removed in O(log n).
+//
+// A bounded heap keeps at most a fixed number of elements: once full, pushing discards
+// the maximum. To keep the N largest elements instead, invert the less function.
+type MinMaxHeap[T any] struct {
+	elements []T
+	less     func(a, b T) bool
+	capacity int
+}
+
+// NewMinMaxHeap creates a new empty, unbounded MinMaxHeap ordered by less.
+//
+// Example:
+//  h := NewMinMaxHeap(func(a, b int) bool { return a < b })
+//  h.Push(3)
+//  h.Push(1)
+//  h.Push(2)
+//  fmt.Println(h.PeekMin(), h.PeekMax()) // Output: 1 3
+func NewMinMaxHeap[T any](less func(a, b T) bool) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{less: less}
+}
+
+// NewMinMaxHeapFrom creates an unbounded MinMaxHeap holding a copy of items.
+// The heap is built in O(n).
+func NewMinMaxHeapFrom[T any](items []T, less func(a, b T) bool) *MinMaxHeap[T] {
+	h := &MinMaxHeap[T]{
+		elements: make([]T, len(items)),
+		less:     less,
+	}
+	copy(h.elements, items)
+	for i := len(h.elements)/2 - 1; i >= 0; i-- {
+		h.trickleDown(i)
+	}
+	return h
+}
+
+// NewBoundedMinMaxHeap creates a MinMaxHeap that holds at most capacity elements,
+// discarding the maximum whenever a push would exceed the capacity.
+//
+// Example:
+//  best := NewBoundedMinMaxHeap(3, func(a, b int) bool { return a < b })
+//  for _, v := range []int{5, 1, 4, 2, 3} {
+//  	best.Push(v)
+//  }
+//  fmt.Println(best.PeekMax()) // Output: 3
+func NewBoundedMinMaxHeap[T any](capacity int, less func(a, b T) bool) *MinMaxHeap[T] {
+	if capacity <= 0 {
+		panic("Capacity must be positive.")
+	}
+
+	return &MinMaxHeap[T]{
+		elements: make([]T, 0, capacity),
+		less:     less,
+		capacity: capacity,
+	}
+}
+
+// Push adds an element to the heap.
+// For a full bounded heap the maximum of the existing elements and the new element is
+// discarded and returned with true; otherwise Push returns the zero value and false.
+func (h *MinMaxHeap[T]) Push(element T) (T, bool) {
+	if h.capacity > 0 && len(h.elements) >= h.capacity {
+		return h.PushPopMax(element), true
+	}
+
+	h.elements = append(h.elements, element)
+	h.bubbleUp(len(h.elements) - 1)
+	var zeroValue T
+	return zeroValue, false
+}
+
+// PushPopMin pushes element and then removes and returns the minimum.
+// It is more efficient than calling Push followed by PopMin.
+func (h *MinMaxHeap[T]) PushPopMin(element T) T {
+	if len(h.elements) == 0 || !h.less(h.elements[0], element) {
+		return element
+	}
+
+	minimum := h.elements[0]
+	h.elements[0] = element
+	h.trickleDown(0)
+	return minimum
+}
+
+// PushPopMax pushes element and then removes and returns the maximum.
+// It is more efficient than calling Push followed by PopMax.
+func (h *MinMaxHeap[T]) PushPopMax(element T) T {
+	if len(h.elements) == 0 {
+		return element
+	}
+
+	i := h.maxIndex()
+	if !h.less(element, h.elements[i]) {
+		return element
+	}
+
+	maximum := h.elements[i]
+	h.elements[i] = element
+	if i > 0 && h.less(h.elements[i], h.elements[0]) {
+		h.swap(i, 0)
+	}
+	h.trickleDown(i)
+	return maximum
+}
+
+// PeekMin returns the minimum element without removing it.
+// It panics if the heap is empty.
+func (h *MinMaxHeap[T]) PeekMin() T {
+	if len(h.elements) == 0 {
+		panic("Peek from an empty heap")
+	}
+
+	return h.elements[0]
+}
+
+// PeekMax returns the maximum element without removing it.
+// It panics if the heap is empty.
+func (h *MinMaxHeap[T]) PeekMax() T {
+	if len(h.elements) == 0 {
+		panic("Peek from an empty heap")
+	}
+
+	return h.elements[h.maxIndex()]
+}
+
+// PopMin removes and returns the minimum element.
+// It panics if the heap is empty.
+func (h *MinMaxHeap[T]) PopMin() T {
+	if len(h.elements) == 0 {
+		panic("Pop from an empty heap")
+	}
+
+	return h.removeAt(0)
+}
+
+// PopMax removes and returns the maximum element.
+// It panics if the heap is empty.
+func (h *MinMaxHeap[T]) PopMax() T {
+	if len(h.elements) == 0 {
+		panic("Pop from an empty heap")
+	}
+
+	return h.removeAt(h.maxIndex())
+}
+
+// Len returns the number of elements in the heap.
+func (h *MinMaxHeap[T]) Len() int {
+	return len(h.elements)
+}
+
+// IsEmpty returns true if the heap is empty, false otherwise.
+func (h *MinMaxHeap[T]) IsEmpty() bool {
+	return len(h.elements) == 0
+}
+
+// Capacity returns the maximum number of elements of a bounded heap, or 0 if the heap is unbounded.
+func (h *MinMaxHeap[T]) Capacity() int {
+	return h.capacity
+}
+
+// Clear removes all elements from the heap.
+func (h *MinMaxHeap[T]) Clear() {
+	var zeroValue T
+	for i := range h.elements {
+		h.elements[i] = zeroValue
+	}
+	h.elements = h.elements[:0]
+}
+
+// Items returns a copy of the elements in heap order, which is not sorted order.
+func (h *MinMaxHeap[T]) Items() []T {
+	items := make([]T, len(h.elements))
+	copy(items, h.elements)
+	return items
+}
+
+func (h *MinMaxHeap[T]) maxIndex() int {
+	switch len(h.elements) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if h.less(h.elements[1], h.elements[2]) {
+		return 2
+	}
+	return 1
+}
+
+func (h *MinMaxHeap[T]) removeAt(i int) T {
+	n := len(h.elements) - 1
+	removed := h.elements[i]
+	h.elements[i] = h.elements[n]
+	var zeroValue T
+	h.elements[n] = zeroValue
+	h.elements = h.elements[:n]
+	if i < n {
+		h.trickleDown(i)
+	}
+	return removed
+}
+
+func (h *MinMaxHeap[T]) swap(i, j int) {
+	h.elements[i], h.elements[j] = h.elements[j], h.elements[i]
+}
+
+// isMinLevel reports whether index i lies on a min level (the root is on level 0).
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+func (h *MinMaxHeap[T]) bubbleUp(i int) {
+	if i == 0 {
+		return
+	}
+
+	parent := (i - 1) / 2
+	if isMinLevel(i) {
+		if h.less(h.elements[parent], h.elements[i]) {
+			h.swap(i, parent)
+			h.bubbleUpLevel(parent, false)
+		} else {
+			h.bubbleUpLevel(i, true)
+		}
+	} else {
+		if h.less(h.elements[i], h.elements[parent]) {
+			h.swap(i, parent)
+			h.bubbleUpLevel(parent, true)
+		} else {
+			h.bubbleUpLevel(i, false)
+		}
+	}
+}
+
+// bubbleUpLevel moves the element at i up through its grandparents, which share its min or max level.
+func (h *MinMaxHeap[T]) bubbleUpLevel(i int, minLevel bool) {
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if !h.before(h.elements[i], h.elements[grandparent], minLevel) {
+			return
+		}
+		h.swap(i, grandparent)
+		i = grandparent
+	}
+}
+
+func (h *MinMaxHeap[T]) trickleDown(i int) {
+	minLevel := isMinLevel(i)
+	n := len(h.elements)
+	for {
+		// Find the extreme element among the children and grandchildren of i.
+		m := -1
+		first := 2*i + 1
+		for _, c := range [...]int{first, first + 1, 2*first + 1, 2*first + 2, 2*first + 3, 2*first + 4} {
+			if c >= n {
+				continue
+			}
+			if m == -1 || h.before(h.elements[c], h.elements[m], minLevel) {
+				m = c
+			}
+		}
+		if m == -1 || !h.before(h.elements[m], h.elements[i], minLevel) {
+			return
+		}
+
+		h.swap(m, i)
+		if m <= first+1 {
+			return
+		}
+
+		parent := (m - 1) / 2
+		if h.before(h.elements[parent], h.elements[m], minLevel) {
+			h.swap(m, parent)
+		}
+		i = m
+	}
+}
+
+// before reports whether a should sit above b on a min level (a < b) or on a max level (a > b).
+func (h *MinMaxHeap[T]) before(a, b T, minLevel bool) bool {
+	if minLevel {
+		return h.less(a, b)
+	}
+	return h.less(b, a)
+}
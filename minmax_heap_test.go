@@ -0,0 +1,131 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestMinMaxHeap(t *testing.T) {
+	h := NewMinMaxHeap(intLess)
+	for _, v := range []int{5, 3, 8, 1, 9, 2, 7} {
+		h.Push(v)
+	}
+
+	if min, max := h.PeekMin(), h.PeekMax(); min != 1 || max != 9 {
+		t.Errorf("PeekMin(), PeekMax() = %d, %d; want 1, 9", min, max)
+	}
+	if v := h.PopMax(); v != 9 {
+		t.Errorf("PopMax() = %d; want 9", v)
+	}
+	if v := h.PopMin(); v != 1 {
+		t.Errorf("PopMin() = %d; want 1", v)
+	}
+	if v := h.PushPopMin(0); v != 0 {
+		t.Errorf("PushPopMin(0) = %d; want 0", v)
+	}
+	if v := h.PushPopMin(4); v != 2 {
+		t.Errorf("PushPopMin(4) = %d; want 2", v)
+	}
+	if v := h.PushPopMax(10); v != 10 {
+		t.Errorf("PushPopMax(10) = %d; want 10", v)
+	}
+	if v := h.PushPopMax(6); v != 8 {
+		t.Errorf("PushPopMax(6) = %d; want 8", v)
+	}
+
+	var got []int
+	for !h.IsEmpty() {
+		got = append(got, h.PopMin())
+	}
+	want := []int{3, 4, 5, 6, 7}
+	if len(got) != len(want) {
+		t.Fatalf("PopMin sequence = %v; want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("PopMin sequence = %v; want %v", got, want)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when popping an empty heap, but did not panic")
+		}
+	}()
+	h.PopMax()
+}
+
+func TestMinMaxHeap_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	initial := make([]int, 50)
+	for i := range initial {
+		initial[i] = r.Intn(100)
+	}
+	h := NewMinMaxHeapFrom(initial, intLess)
+	reference := append([]int(nil), initial...)
+
+	for i := 0; i < 5000; i++ {
+		sort.Ints(reference)
+		switch r.Intn(5) {
+		case 0, 1:
+			v := r.Intn(100)
+			h.Push(v)
+			reference = append(reference, v)
+		case 2:
+			if len(reference) > 0 {
+				if got := h.PopMin(); got != reference[0] {
+					t.Fatalf("PopMin() = %d; want %d", got, reference[0])
+				}
+				reference = reference[1:]
+			}
+		case 3:
+			if len(reference) > 0 {
+				if got := h.PopMax(); got != reference[len(reference)-1] {
+					t.Fatalf("PopMax() = %d; want %d", got, reference[len(reference)-1])
+				}
+				reference = reference[:len(reference)-1]
+			}
+		case 4:
+			v := r.Intn(100)
+			reference = append(reference, v)
+			sort.Ints(reference)
+			if got := h.PushPopMax(v); got != reference[len(reference)-1] {
+				t.Fatalf("PushPopMax(%d) = %d; want %d", v, got, reference[len(reference)-1])
+			}
+			reference = reference[:len(reference)-1]
+		}
+		if h.Len() != len(reference) {
+			t.Fatalf("Len() = %d; want %d", h.Len(), len(reference))
+		}
+	}
+}
+
+func TestMinMaxHeap_Bounded(t *testing.T) {
+	h := NewBoundedMinMaxHeap(3, intLess)
+
+	var evicted []int
+	for _, v := range []int{5, 1, 4, 2, 3, 9} {
+		if e, ok := h.Push(v); ok {
+			evicted = append(evicted, e)
+		}
+	}
+
+	if h.Len() != 3 {
+		t.Errorf("Len() = %d; want 3", h.Len())
+	}
+	if max := h.PeekMax(); max != 3 {
+		t.Errorf("PeekMax() = %d; want 3", max)
+	}
+	want := []int{5, 4, 9}
+	if len(evicted) != len(want) {
+		t.Fatalf("evicted = %v; want %v", evicted, want)
+	}
+	for i := range want {
+		if evicted[i] != want[i] {
+			t.Fatalf("evicted = %v; want %v", evicted, want)
+		}
+	}
+}
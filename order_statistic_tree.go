@@ -0,0 +1,232 @@
+package collections
+
+import (
+	"cmp"
+	"math/rand/v2"
+)
+
+// OrderStatisticTree is a sorted set that can also answer positional queries: the rank of an item
+// and the item at a given rank, both in O(log n) expected time. It is a treap whose nodes record
+// the size of their subtree.
+//
+// Example:
+//  scores := NewOrderStatisticTree[int]()
+//  for _, s := range []int{70, 95, 80, 60} {
+//  	scores.Add(s)
+//  }
+//  fmt.Println(scores.Rank(80))  // Output: 2
+//  fmt.Println(scores.Select(3)) // Output: 95
+type OrderStatisticTree[T any] struct {
+	root *ostNode[T]
+	less func(a, b T) bool
+}
+
+type ostNode[T any] struct {
+	item        T
+	priority    uint64
+	size        int
+	left, right *ostNode[T]
+}
+
+// NewOrderStatisticTree creates a new empty OrderStatisticTree ordered by the natural order of T.
+func NewOrderStatisticTree[T cmp.Ordered]() *OrderStatisticTree[T] {
+	return NewOrderStatisticTreeFunc(cmp.Less[T])
+}
+
+// NewOrderStatisticTreeFunc creates a new empty OrderStatisticTree ordered by less.
+// Items for which neither is less than the other are considered equal.
+func NewOrderStatisticTreeFunc[T any](less func(a, b T) bool) *OrderStatisticTree[T] {
+	return &OrderStatisticTree[T]{less: less}
+}
+
+func (n *ostNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *ostNode[T]) update() {
+	n.size = 1 + n.left.getSize() + n.right.getSize()
+}
+
+// split divides the treap n into the items less than item and the rest.
+func (t *OrderStatisticTree[T]) split(n *ostNode[T], item T) (*ostNode[T], *ostNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if t.less(n.item, item) {
+		left, right := t.split(n.right, item)
+		n.right = left
+		n.update()
+		return n, right
+	}
+	left, right := t.split(n.left, item)
+	n.left = right
+	n.update()
+	return left, n
+}
+
+// mergeOST joins the treaps a and b, where every item in a is less than every item in b.
+func mergeOST[T any](a, b *ostNode[T]) *ostNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = mergeOST(a.right, b)
+		a.update()
+		return a
+	}
+	b.left = mergeOST(a, b.left)
+	b.update()
+	return b
+}
+
+// Add adds an item to the tree.
+// Returns true if the item was added, false if it was already present.
+func (t *OrderStatisticTree[T]) Add(item T) bool {
+	if t.Contains(item) {
+		return false
+	}
+	left, right := t.split(t.root, item)
+	n := &ostNode[T]{item: item, priority: rand.Uint64(), size: 1}
+	t.root = mergeOST(mergeOST(left, n), right)
+	return true
+}
+
+// Remove removes an item from the tree.
+// Returns true if the item was removed, false if it was not present.
+func (t *OrderStatisticTree[T]) Remove(item T) bool {
+	if !t.Contains(item) {
+		return false
+	}
+	t.root = t.remove(t.root, item)
+	return true
+}
+
+func (t *OrderStatisticTree[T]) remove(n *ostNode[T], item T) *ostNode[T] {
+	switch {
+	case t.less(item, n.item):
+		n.left = t.remove(n.left, item)
+	case t.less(n.item, item):
+		n.right = t.remove(n.right, item)
+	default:
+		return mergeOST(n.left, n.right)
+	}
+	n.update()
+	return n
+}
+
+// Contains checks if an item is present in the tree.
+func (t *OrderStatisticTree[T]) Contains(item T) bool {
+	for n := t.root; n != nil; {
+		switch {
+		case t.less(item, n.item):
+			n = n.left
+		case t.less(n.item, item):
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of items in the tree.
+func (t *OrderStatisticTree[T]) Count() int {
+	return t.root.getSize()
+}
+
+// Clear removes all items from the tree.
+func (t *OrderStatisticTree[T]) Clear() {
+	t.root = nil
+}
+
+// Rank returns the number of items less than item, which is item's zero-based position
+// in sorted order if it is present.
+func (t *OrderStatisticTree[T]) Rank(item T) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		if t.less(n.item, item) {
+			rank += n.left.getSize() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// Select returns the item at zero-based position k in sorted order.
+// It panics if k is out of range.
+func (t *OrderStatisticTree[T]) Select(k int) T {
+	if k < 0 || k >= t.Count() {
+		panic("Index out of range.")
+	}
+	n := t.root
+	for {
+		leftSize := n.left.getSize()
+		switch {
+		case k < leftSize:
+			n = n.left
+		case k > leftSize:
+			k -= leftSize + 1
+			n = n.right
+		default:
+			return n.item
+		}
+	}
+}
+
+// CountInRange returns the number of items in the half-open range [lo, hi).
+func (t *OrderStatisticTree[T]) CountInRange(lo, hi T) int {
+	if !t.less(lo, hi) {
+		return 0
+	}
+	return t.Rank(hi) - t.Rank(lo)
+}
+
+// Min returns the smallest item.
+// The boolean result is false if the tree is empty.
+func (t *OrderStatisticTree[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return t.Select(0), true
+}
+
+// Max returns the largest item.
+// The boolean result is false if the tree is empty.
+func (t *OrderStatisticTree[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return t.Select(t.Count() - 1), true
+}
+
+// Ascend calls fn for every item in ascending order until fn returns false.
+func (t *OrderStatisticTree[T]) Ascend(fn func(item T) bool) {
+	ascendOST(t.root, fn)
+}
+
+func ascendOST[T any](n *ostNode[T], fn func(item T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return ascendOST(n.left, fn) && fn(n.item) && ascendOST(n.right, fn)
+}
+
+// Items returns a slice of all items in ascending order.
+func (t *OrderStatisticTree[T]) Items() []T {
+	items := make([]T, 0, t.Count())
+	t.Ascend(func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
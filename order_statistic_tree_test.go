@@ -0,0 +1,120 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestOrderStatisticTree(t *testing.T) {
+	tree := NewOrderStatisticTree[int]()
+	for _, v := range []int{50, 20, 80, 10, 30, 70, 90} {
+		if !tree.Add(v) {
+			t.Errorf("Add(%d) = false; want true", v)
+		}
+	}
+	if tree.Add(30) {
+		t.Error("Add(30) again = true; want false")
+	}
+
+	assertSliceEqual(t, tree.Items(), []int{10, 20, 30, 50, 70, 80, 90})
+	if rank := tree.Rank(50); rank != 3 {
+		t.Errorf("Rank(50) = %d; want 3", rank)
+	}
+	if rank := tree.Rank(55); rank != 4 {
+		t.Errorf("Rank(55) = %d; want 4", rank)
+	}
+	if v := tree.Select(5); v != 80 {
+		t.Errorf("Select(5) = %d; want 80", v)
+	}
+	if n := tree.CountInRange(20, 80); n != 4 {
+		t.Errorf("CountInRange(20, 80) = %d; want 4", n)
+	}
+	if n := tree.CountInRange(80, 20); n != 0 {
+		t.Errorf("CountInRange(80, 20) = %d; want 0", n)
+	}
+	if v, _ := tree.Min(); v != 10 {
+		t.Errorf("Min() = %d; want 10", v)
+	}
+	if v, _ := tree.Max(); v != 90 {
+		t.Errorf("Max() = %d; want 90", v)
+	}
+
+	if !tree.Remove(50) || tree.Remove(50) || tree.Contains(50) {
+		t.Error("Remove(50) did not remove exactly once")
+	}
+	if v := tree.Select(3); v != 70 || tree.Count() != 6 {
+		t.Errorf("Select(3) = %d, Count() = %d; want 70, 6", v, tree.Count())
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for an out of range Select, but did not panic")
+		}
+	}()
+	tree.Select(6)
+}
+
+func TestOrderStatisticTree_Leaderboard(t *testing.T) {
+	type entry struct {
+		score int
+		name  string
+	}
+	// Higher scores rank first; ties are broken by name.
+	board := NewOrderStatisticTreeFunc(func(a, b entry) bool {
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		return a.name < b.name
+	})
+	board.Add(entry{90, "ann"})
+	board.Add(entry{75, "bob"})
+	board.Add(entry{90, "cid"})
+	board.Add(entry{60, "dee"})
+
+	if rank := board.Rank(entry{90, "cid"}); rank != 1 {
+		t.Errorf("Rank(cid) = %d; want 1", rank)
+	}
+	if top := board.Select(0); top.name != "ann" {
+		t.Errorf("Select(0) = %v; want ann", top)
+	}
+}
+
+func TestOrderStatisticTree_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	tree := NewOrderStatisticTree[int]()
+	reference := map[int]bool{}
+
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			if tree.Remove(v) != reference[v] {
+				t.Fatalf("Remove(%d) != %v", v, reference[v])
+			}
+			delete(reference, v)
+		} else {
+			if tree.Add(v) == reference[v] {
+				t.Fatalf("Add(%d) == %v", v, reference[v])
+			}
+			reference[v] = true
+		}
+	}
+
+	sorted := make([]int, 0, len(reference))
+	for v := range reference {
+		sorted = append(sorted, v)
+	}
+	sort.Ints(sorted)
+	assertSliceEqual(t, tree.Items(), sorted)
+	for k, v := range sorted {
+		if got := tree.Select(k); got != v {
+			t.Fatalf("Select(%d) = %d; want %d", k, got, v)
+		}
+		if rank := tree.Rank(v); rank != k {
+			t.Fatalf("Rank(%d) = %d; want %d", v, rank, k)
+		}
+	}
+	if n, want := tree.CountInRange(100, 600), sort.SearchInts(sorted, 600)-sort.SearchInts(sorted, 100); n != want {
+		t.Errorf("CountInRange(100, 600) = %d; want %d", n, want)
+	}
+}
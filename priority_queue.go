@@ -0,0 +1,301 @@
+package collections
+
+// PriorityQueue is a generic priority queue backed by a binary heap.
+// The element for which less reports true against every other element is dequeued first,
+// so a less function of `a < b` yields a min-queue.
+type PriorityQueue[T any] struct {
+	elements []T
+	less     func(a, b T) bool
+}
+
+// NewPriorityQueue creates a new empty PriorityQueue ordered by less.
+//
+// Example:
+//  pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+//  pq.Push(3)
+//  pq.Push(1)
+//  fmt.Println(pq.Pop()) // Output: 1
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// NewPriorityQueueFrom creates a PriorityQueue holding a copy of items.
+// The heap is built in O(n).
+func NewPriorityQueueFrom[T any](items []T, less func(a, b T) bool) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{
+		elements: make([]T, len(items)),
+		less:     less,
+	}
+	copy(pq.elements, items)
+	for i := len(pq.elements)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+	return pq
+}
+
+// Push adds an element to the queue in O(log n).
+func (pq *PriorityQueue[T]) Push(element T) {
+	pq.elements = append(pq.elements, element)
+	pq.up(len(pq.elements) - 1)
+}
+
+// Pop removes and returns the highest-priority element in O(log n).
+// It panics if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() T {
+	if len(pq.elements) == 0 {
+		panic("Pop from an empty priority queue")
+	}
+
+	n := len(pq.elements) - 1
+	top := pq.elements[0]
+	pq.elements[0] = pq.elements[n]
+	var zeroValue T
+	pq.elements[n] = zeroValue
+	pq.elements = pq.elements[:n]
+	if n > 0 {
+		pq.down(0)
+	}
+	return top
+}
+
+// Peek returns the highest-priority element without removing it.
+// It panics if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() T {
+	if len(pq.elements) == 0 {
+		panic("Peek from an empty priority queue")
+	}
+
+	return pq.elements[0]
+}
+
+// Len returns the number of elements in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.elements)
+}
+
+// IsEmpty returns true if the queue is empty, false otherwise.
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.elements) == 0
+}
+
+// Clear removes all elements from the queue.
+func (pq *PriorityQueue[T]) Clear() {
+	pq.elements = nil
+}
+
+// Items returns a copy of the elements in heap order, which is not sorted order.
+func (pq *PriorityQueue[T]) Items() []T {
+	items := make([]T, len(pq.elements))
+	copy(items, pq.elements)
+	return items
+}
+
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.elements[i], pq.elements[parent]) {
+			break
+		}
+		pq.elements[i], pq.elements[parent] = pq.elements[parent], pq.elements[i]
+		i = parent
+	}
+}
+
+func (pq *PriorityQueue[T]) down(i int) {
+	n := len(pq.elements)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && pq.less(pq.elements[left], pq.elements[smallest]) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.elements[right], pq.elements[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		pq.elements[i], pq.elements[smallest] = pq.elements[smallest], pq.elements[i]
+		i = smallest
+	}
+}
+
+// PriorityQueueHandle identifies an element stored in an IndexedPriorityQueue.
+type PriorityQueueHandle[T any] struct {
+	value T
+	index int
+	queue *IndexedPriorityQueue[T]
+}
+
+// Value returns the element the handle refers to.
+func (h *PriorityQueueHandle[T]) Value() T {
+	return h.value
+}
+
+// IndexedPriorityQueue is a PriorityQueue that returns a handle for every pushed element,
+// so that the element can later be re-prioritised or removed in O(log n).
+type IndexedPriorityQueue[T any] struct {
+	elements []*PriorityQueueHandle[T]
+	less     func(a, b T) bool
+}
+
+// NewIndexedPriorityQueue creates a new empty IndexedPriorityQueue ordered by less.
+//
+// Example:
+//  pq := NewIndexedPriorityQueue(func(a, b int) bool { return a < b })
+//  h := pq.Push(10)
+//  pq.Push(5)
+//  pq.DecreaseKey(h, 1)
+//  fmt.Println(pq.Pop()) // Output: 1
+func NewIndexedPriorityQueue[T any](less func(a, b T) bool) *IndexedPriorityQueue[T] {
+	return &IndexedPriorityQueue[T]{less: less}
+}
+
+// Push adds an element to the queue and returns its handle.
+func (pq *IndexedPriorityQueue[T]) Push(element T) *PriorityQueueHandle[T] {
+	h := &PriorityQueueHandle[T]{value: element, index: len(pq.elements), queue: pq}
+	pq.elements = append(pq.elements, h)
+	pq.up(h.index)
+	return h
+}
+
+// Pop removes and returns the highest-priority element.
+// It panics if the queue is empty.
+func (pq *IndexedPriorityQueue[T]) Pop() T {
+	if len(pq.elements) == 0 {
+		panic("Pop from an empty priority queue")
+	}
+
+	return pq.removeAt(0).value
+}
+
+// Peek returns the highest-priority element without removing it.
+// It panics if the queue is empty.
+func (pq *IndexedPriorityQueue[T]) Peek() T {
+	if len(pq.elements) == 0 {
+		panic("Peek from an empty priority queue")
+	}
+
+	return pq.elements[0].value
+}
+
+// PeekHandle returns the handle of the highest-priority element without removing it.
+// It panics if the queue is empty.
+func (pq *IndexedPriorityQueue[T]) PeekHandle() *PriorityQueueHandle[T] {
+	if len(pq.elements) == 0 {
+		panic("Peek from an empty priority queue")
+	}
+
+	return pq.elements[0]
+}
+
+// Contains reports whether the handle refers to an element still in this queue.
+func (pq *IndexedPriorityQueue[T]) Contains(h *PriorityQueueHandle[T]) bool {
+	return h != nil && h.queue == pq && h.index >= 0
+}
+
+// Update replaces the element behind the handle and restores heap order.
+// It panics if the handle does not belong to the queue.
+func (pq *IndexedPriorityQueue[T]) Update(h *PriorityQueueHandle[T], element T) {
+	pq.mustContain(h)
+	h.value = element
+	if !pq.up(h.index) {
+		pq.down(h.index)
+	}
+}
+
+// DecreaseKey replaces the element behind the handle with one of equal or higher priority.
+// It panics if the handle does not belong to the queue or if element has a lower priority.
+func (pq *IndexedPriorityQueue[T]) DecreaseKey(h *PriorityQueueHandle[T], element T) {
+	pq.mustContain(h)
+	if pq.less(h.value, element) {
+		panic("DecreaseKey would lower the element's priority")
+	}
+	h.value = element
+	pq.up(h.index)
+}
+
+// Remove removes the element behind the handle and returns it.
+// It panics if the handle does not belong to the queue.
+func (pq *IndexedPriorityQueue[T]) Remove(h *PriorityQueueHandle[T]) T {
+	pq.mustContain(h)
+	return pq.removeAt(h.index).value
+}
+
+// Len returns the number of elements in the queue.
+func (pq *IndexedPriorityQueue[T]) Len() int {
+	return len(pq.elements)
+}
+
+// IsEmpty returns true if the queue is empty, false otherwise.
+func (pq *IndexedPriorityQueue[T]) IsEmpty() bool {
+	return len(pq.elements) == 0
+}
+
+// Clear removes all elements from the queue and invalidates their handles.
+func (pq *IndexedPriorityQueue[T]) Clear() {
+	for _, h := range pq.elements {
+		h.index = -1
+	}
+	pq.elements = nil
+}
+
+func (pq *IndexedPriorityQueue[T]) mustContain(h *PriorityQueueHandle[T]) {
+	if !pq.Contains(h) {
+		panic("Handle does not belong to this priority queue")
+	}
+}
+
+func (pq *IndexedPriorityQueue[T]) removeAt(i int) *PriorityQueueHandle[T] {
+	n := len(pq.elements) - 1
+	h := pq.elements[i]
+	if i != n {
+		pq.swap(i, n)
+	}
+	pq.elements[n] = nil
+	pq.elements = pq.elements[:n]
+	if i != n && !pq.up(i) {
+		pq.down(i)
+	}
+	h.index = -1
+	return h
+}
+
+func (pq *IndexedPriorityQueue[T]) swap(i, j int) {
+	pq.elements[i], pq.elements[j] = pq.elements[j], pq.elements[i]
+	pq.elements[i].index = i
+	pq.elements[j].index = j
+}
+
+// up moves the element at i towards the root and reports whether it moved.
+func (pq *IndexedPriorityQueue[T]) up(i int) bool {
+	start := i
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.elements[i].value, pq.elements[parent].value) {
+			break
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+	return i != start
+}
+
+func (pq *IndexedPriorityQueue[T]) down(i int) {
+	n := len(pq.elements)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && pq.less(pq.elements[left].value, pq.elements[smallest].value) {
+			smallest = left
+		}
+		if right < n && pq.less(pq.elements[right].value, pq.elements[smallest].value) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
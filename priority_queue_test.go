@@ -0,0 +1,140 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+	for _, v := range []int{5, 3, 8, 1, 9, 2} {
+		pq.Push(v)
+	}
+
+	if pq.Len() != 6 {
+		t.Errorf("Len() = %d; want 6", pq.Len())
+	}
+	if top := pq.Peek(); top != 1 {
+		t.Errorf("Peek() = %d; want 1", top)
+	}
+
+	expected := []int{1, 2, 3, 5, 8, 9}
+	for _, want := range expected {
+		if got := pq.Pop(); got != want {
+			t.Errorf("Pop() = %d; want %d", got, want)
+		}
+	}
+	if !pq.IsEmpty() {
+		t.Error("IsEmpty() = false; want true")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when popping an empty queue, but did not panic")
+		}
+	}()
+	pq.Pop()
+}
+
+func TestPriorityQueue_Heapify(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	items := make([]int, 200)
+	for i := range items {
+		items[i] = r.Intn(1000)
+	}
+
+	pq := NewPriorityQueueFrom(items, func(a, b int) bool { return a > b })
+	sorted := append([]int(nil), items...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	for i, want := range sorted {
+		if got := pq.Pop(); got != want {
+			t.Fatalf("Pop() #%d = %d; want %d", i, got, want)
+		}
+	}
+}
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	pq := NewIndexedPriorityQueue(func(a, b task) bool { return a.priority < b.priority })
+
+	a := pq.Push(task{"a", 10})
+	b := pq.Push(task{"b", 20})
+	c := pq.Push(task{"c", 30})
+	pq.Push(task{"d", 40})
+
+	pq.DecreaseKey(c, task{"c", 5})
+	if top := pq.Peek(); top.name != "c" {
+		t.Errorf("Peek() = %s; want c", top.name)
+	}
+
+	pq.Update(c, task{"c", 50})
+	if top := pq.Peek(); top.name != "a" {
+		t.Errorf("Peek() after Update = %s; want a", top.name)
+	}
+
+	if removed := pq.Remove(b); removed.name != "b" {
+		t.Errorf("Remove() = %s; want b", removed.name)
+	}
+	if pq.Contains(b) {
+		t.Error("Contains() after Remove = true; want false")
+	}
+
+	var order []string
+	for !pq.IsEmpty() {
+		order = append(order, pq.Pop().name)
+	}
+	if len(order) != 3 || order[0] != "a" || order[1] != "d" || order[2] != "c" {
+		t.Errorf("Pop order = %v; want [a d c]", order)
+	}
+	if pq.Contains(a) {
+		t.Error("Contains() after Pop = true; want false")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for a stale handle, but did not panic")
+		}
+	}()
+	pq.Update(a, task{"a", 1})
+}
+
+func TestIndexedPriorityQueue_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	pq := NewIndexedPriorityQueue(func(a, b int) bool { return a < b })
+	live := map[*PriorityQueueHandle[int]]bool{}
+
+	for i := 0; i < 2000; i++ {
+		switch op := r.Intn(4); {
+		case op < 2 || len(live) == 0:
+			live[pq.Push(r.Intn(1000))] = true
+		case op == 2:
+			for h := range live {
+				pq.Update(h, r.Intn(1000))
+				break
+			}
+		default:
+			for h := range live {
+				pq.Remove(h)
+				delete(live, h)
+				break
+			}
+		}
+	}
+
+	if pq.Len() != len(live) {
+		t.Fatalf("Len() = %d; want %d", pq.Len(), len(live))
+	}
+	prev := -1
+	for !pq.IsEmpty() {
+		v := pq.Pop()
+		if v < prev {
+			t.Fatalf("Pop() = %d after %d; heap order violated", v, prev)
+		}
+		prev = v
+	}
+}
@@ -1,8 +1,9 @@
 package collections
 
-// Queue represents a generic, thread-safe queue data structure.
+// Queue represents a generic FIFO queue data structure backed by a ring buffer.
+// Enqueue and Dequeue run in amortised O(1) and memory use stays proportional to the number of queued elements.
 type Queue[T comparable] struct {
-	elements []T
+	elements Deque[T]
 }
 
 // NewQueue creates a new instance of a Queue.
@@ -12,37 +13,76 @@ func NewQueue[T comparable]() *Queue[T] {
 
 // Enqueue adds an element to the end of the queue.
 func (q *Queue[T]) Enqueue(element T) {
-	q.elements = append(q.elements, element)
+	q.elements.PushBack(element)
+}
+
+// EnqueueRange adds multiple elements to the end of the queue, in order.
+func (q *Queue[T]) EnqueueRange(elements []T) {
+	for _, element := range elements {
+		q.elements.PushBack(element)
+	}
 }
 
 // Dequeue removes and returns the element from the front of the queue.
 // It panics if the queue is empty.
 func (q *Queue[T]) Dequeue() T {
-	if len(q.elements) == 0 {
+	if q.elements.IsEmpty() {
 		panic("Dequeue from an empty queue")
 	}
 
-	element := q.elements[0]
-	q.elements = q.elements[1:]
-	return element
+	return q.elements.PopFront()
+}
+
+// DequeueRange removes and returns up to count elements from the front of the queue.
+func (q *Queue[T]) DequeueRange(count int) []T {
+	if count < 0 {
+		panic("Count cannot be negative.")
+	}
+
+	count = min(count, q.elements.Size())
+	result := make([]T, count)
+	for i := range result {
+		result[i] = q.elements.PopFront()
+	}
+	return result
 }
 
 // Peek returns the element at the front of the queue without removing it.
 // It panics if the queue is empty.
 func (q *Queue[T]) Peek() T {
-	if len(q.elements) == 0 {
+	if q.elements.IsEmpty() {
 		panic("Peek from an empty queue")
 	}
 
-	return q.elements[0]
+	return q.elements.PeekFront()
+}
+
+// Contains checks if the queue contains the specified element.
+func (q *Queue[T]) Contains(element T) bool {
+	for i := 0; i < q.elements.Size(); i++ {
+		if q.elements.Get(i) == element {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all elements from the queue.
+func (q *Queue[T]) Clear() {
+	q.elements.Clear()
+}
+
+// ToSlice returns the elements of the queue from front to back.
+func (q *Queue[T]) ToSlice() []T {
+	return q.elements.ToSlice()
 }
 
 // IsEmpty returns true if the queue is empty, false otherwise.
 func (q *Queue[T]) IsEmpty() bool {
-	return len(q.elements) == 0
+	return q.elements.IsEmpty()
 }
 
 // Size returns the number of elements in the queue.
 func (q *Queue[T]) Size() int {
-	return len(q.elements)
+	return q.elements.Size()
 }
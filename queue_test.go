@@ -0,0 +1,56 @@
+package collections
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.EnqueueRange([]int{2, 3, 4})
+
+	if size := q.Size(); size != 4 {
+		t.Errorf("Size() = %d; want 4", size)
+	}
+	if v := q.Peek(); v != 1 {
+		t.Errorf("Peek() = %d; want 1", v)
+	}
+	if v := q.Dequeue(); v != 1 {
+		t.Errorf("Dequeue() = %d; want 1", v)
+	}
+	if !q.Contains(3) || q.Contains(1) {
+		t.Errorf("Contains() reported the wrong membership for %v", q.ToSlice())
+	}
+
+	assertSliceEqual(t, q.DequeueRange(2), []int{2, 3})
+	assertSliceEqual(t, q.DequeueRange(5), []int{4})
+	if !q.IsEmpty() {
+		t.Error("IsEmpty() = false; want true")
+	}
+
+	q.EnqueueRange([]int{5, 6})
+	q.Clear()
+	if size := q.Size(); size != 0 {
+		t.Errorf("Size() after Clear() = %d; want 0", size)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic when dequeuing an empty queue, but did not panic")
+		}
+	}()
+	q.Dequeue()
+}
+
+func TestQueue_ReusesMemory(t *testing.T) {
+	q := NewQueue[int]()
+	q.Enqueue(-1)
+	for i := 0; i < 100000; i++ {
+		q.Enqueue(i)
+		if v := q.Dequeue(); v != i-1 {
+			t.Fatalf("Dequeue() = %d; want %d", v, i-1)
+		}
+	}
+
+	if capacity := len(q.elements.buf); capacity > minDequeCapacity {
+		t.Errorf("backing capacity = %d; want at most %d for a steady-state queue", capacity, minDequeCapacity)
+	}
+}
@@ -0,0 +1,497 @@
+package collections
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// RadixTree is an ordered map from string keys to values of type V, stored as a radix tree:
+// chains of single-child nodes are compressed into one edge labelled with a whole substring,
+// so memory grows with the number of keys rather than their total length.
+// Keys are compared bytewise and visited in lexicographic order.
+//
+// Nodes are shared copy-on-write, so Snapshot and Txn are O(1) and a snapshot is unaffected
+// by later changes to the tree it came from. A RadixTree is not safe for concurrent writes,
+// but snapshots can be handed to other goroutines for reading: the reading methods, including Snapshot
+// and NewIterator, may be called concurrently on a tree that is not being modified.
+type RadixTree[V any] struct {
+	root *radixNode[V]
+	size int
+	// gen identifies the nodes this tree owns and may modify in place; all others are copied first.
+	// Only the writer changes it.
+	gen uint64
+	// shared is set when the current nodes become visible to a snapshot or iterator. Readers set it instead of
+	// changing gen, so they never write to the tree itself, and the next change takes a new generation.
+	shared atomic.Bool
+}
+
+type radixNode[V any] struct {
+	// prefix is the edge label leading to the node from its parent.
+	prefix string
+	// key is the full key of the node when it holds a value.
+	key      string
+	value    V
+	hasValue bool
+	// children is kept sorted by the first byte of their prefixes, which are all distinct.
+	children []*radixNode[V]
+	gen      uint64
+}
+
+// cowGeneration hands out generation ids for the copy-on-write trees;
+// every tree that may modify nodes in place has its own.
+var cowGeneration atomic.Uint64
+
+// NewRadixTree creates a new empty RadixTree.
+//
+// Example:
+//  tree := NewRadixTree[int]()
+//  tree.Insert("/api/users", 1)
+//  tree.Insert("/api/orders", 2)
+//  key, _, _ := tree.Minimum()
+//  fmt.Println(key) // Output: /api/orders
+func NewRadixTree[V any]() *RadixTree[V] {
+	gen := cowGeneration.Add(1)
+	return &RadixTree[V]{root: &radixNode[V]{gen: gen}, gen: gen}
+}
+
+// own takes a new generation before a change if the nodes have been shared since the last one,
+// so that they are copied instead of modified in place.
+func (t *RadixTree[V]) own() {
+	if t.shared.Load() {
+		t.gen = cowGeneration.Add(1)
+		t.shared.Store(false)
+	}
+}
+
+// writable returns n if the tree owns it, otherwise a copy of n owned by the tree.
+func (t *RadixTree[V]) writable(n *radixNode[V]) *radixNode[V] {
+	if n.gen == t.gen {
+		return n
+	}
+	c := *n
+	c.children = append([]*radixNode[V](nil), n.children...)
+	c.gen = t.gen
+	return &c
+}
+
+// childIndex returns the position of the child whose prefix starts with b, or where it would be inserted.
+func (n *radixNode[V]) childIndex(b byte) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= b })
+	return i, i < len(n.children) && n.children[i].prefix[0] == b
+}
+
+func (n *radixNode[V]) insertChild(child *radixNode[V]) {
+	i, _ := n.childIndex(child.prefix[0])
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = child
+}
+
+func (n *radixNode[V]) removeChild(i int) {
+	last := len(n.children) - 1
+	copy(n.children[i:], n.children[i+1:])
+	n.children[last] = nil
+	n.children = n.children[:last]
+}
+
+// Insert adds or updates the value for key.
+// Returns true if the key was newly added.
+func (t *RadixTree[V]) Insert(key string, value V) bool {
+	t.own()
+	root, added := t.insert(t.root, key, key, value)
+	t.root = root
+	if added {
+		t.size++
+	}
+	return added
+}
+
+func (t *RadixTree[V]) insert(n *radixNode[V], search, key string, value V) (*radixNode[V], bool) {
+	n = t.writable(n)
+	if search == "" {
+		added := !n.hasValue
+		n.key, n.value, n.hasValue = key, value, true
+		return n, added
+	}
+
+	i, ok := n.childIndex(search[0])
+	if !ok {
+		n.insertChild(&radixNode[V]{prefix: search, key: key, value: value, hasValue: true, gen: t.gen})
+		return n, true
+	}
+
+	child := n.children[i]
+	common := commonPrefixLength(child.prefix, search)
+	if common == len(child.prefix) {
+		var added bool
+		n.children[i], added = t.insert(child, search[common:], key, value)
+		return n, added
+	}
+
+	// The key diverges inside the child's edge, so split the edge at the divergence point.
+	split := &radixNode[V]{prefix: search[:common], gen: t.gen}
+	child = t.writable(child)
+	child.prefix = child.prefix[common:]
+	split.children = []*radixNode[V]{child}
+	if common == len(search) {
+		split.key, split.value, split.hasValue = key, value, true
+	} else {
+		split.insertChild(&radixNode[V]{prefix: search[common:], key: key, value: value, hasValue: true, gen: t.gen})
+	}
+	n.children[i] = split
+	return n, true
+}
+
+func commonPrefixLength(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Get retrieves the value for key.
+// Returns the value and true if the key exists, otherwise the zero value and false.
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	n := t.root
+	for search := key; search != ""; {
+		i, ok := n.childIndex(search[0])
+		if !ok || !strings.HasPrefix(search, n.children[i].prefix) {
+			var zeroValue V
+			return zeroValue, false
+		}
+		n = n.children[i]
+		search = search[len(n.prefix):]
+	}
+	return n.value, n.hasValue
+}
+
+// ContainsKey checks if the RadixTree contains key.
+func (t *RadixTree[V]) ContainsKey(key string) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes key from the RadixTree.
+// Returns true if the key was removed, false if it was not present.
+func (t *RadixTree[V]) Delete(key string) bool {
+	t.own()
+	root, removed := t.delete(t.root, key)
+	if removed {
+		t.root = root
+		t.size--
+	}
+	return removed
+}
+
+func (t *RadixTree[V]) delete(n *radixNode[V], search string) (*radixNode[V], bool) {
+	if search == "" {
+		if !n.hasValue {
+			return n, false
+		}
+		n = t.writable(n)
+		var zeroValue V
+		n.key, n.value, n.hasValue = "", zeroValue, false
+		return n, true
+	}
+
+	i, ok := n.childIndex(search[0])
+	if !ok || !strings.HasPrefix(search, n.children[i].prefix) {
+		return n, false
+	}
+	child, removed := t.delete(n.children[i], search[len(n.children[i].prefix):])
+	if !removed {
+		return n, false
+	}
+	n = t.writable(n)
+	t.replaceChild(n, i, child)
+	return n, true
+}
+
+// replaceChild stores child as the i-th child of the writable node n, removing it if it no longer
+// leads to any key and merging it with its only child if it no longer holds a value itself.
+func (t *RadixTree[V]) replaceChild(n *radixNode[V], i int, child *radixNode[V]) {
+	switch {
+	case child == nil || (!child.hasValue && len(child.children) == 0):
+		n.removeChild(i)
+	case !child.hasValue && len(child.children) == 1:
+		merged := t.writable(child.children[0])
+		merged.prefix = child.prefix + merged.prefix
+		n.children[i] = merged
+	default:
+		n.children[i] = child
+	}
+}
+
+// DeletePrefix removes every key that starts with prefix and returns how many were removed.
+//
+// Example:
+//  tree := NewRadixTree[bool]()
+//  tree.Insert("logs/2024/01", true)
+//  tree.Insert("logs/2024/02", true)
+//  tree.Insert("logs/2025/01", true)
+//  fmt.Println(tree.DeletePrefix("logs/2024/")) // Output: 2
+func (t *RadixTree[V]) DeletePrefix(prefix string) int {
+	if prefix == "" {
+		removed := t.size
+		t.Clear()
+		return removed
+	}
+
+	t.own()
+	root, removed := t.deletePrefix(t.root, prefix)
+	if removed > 0 {
+		t.root = root
+		t.size -= removed
+	}
+	return removed
+}
+
+func (t *RadixTree[V]) deletePrefix(n *radixNode[V], search string) (*radixNode[V], int) {
+	i, ok := n.childIndex(search[0])
+	if !ok {
+		return n, 0
+	}
+
+	var child *radixNode[V]
+	var removed int
+	switch c := n.children[i]; {
+	case strings.HasPrefix(c.prefix, search):
+		// Every key below the child starts with the prefix.
+		removed = c.count()
+	case strings.HasPrefix(search, c.prefix):
+		child, removed = t.deletePrefix(c, search[len(c.prefix):])
+	}
+	if removed == 0 {
+		return n, 0
+	}
+	n = t.writable(n)
+	t.replaceChild(n, i, child)
+	return n, removed
+}
+
+func (n *radixNode[V]) count() int {
+	count := 0
+	if n.hasValue {
+		count++
+	}
+	for _, child := range n.children {
+		count += child.count()
+	}
+	return count
+}
+
+// LongestPrefix returns the longest key in the RadixTree that is a prefix of s, along with its value.
+// The boolean result is false if no key is a prefix of s.
+func (t *RadixTree[V]) LongestPrefix(s string) (string, V, bool) {
+	n := t.root
+	last := n
+	for search := s; search != ""; {
+		i, ok := n.childIndex(search[0])
+		if !ok || !strings.HasPrefix(search, n.children[i].prefix) {
+			break
+		}
+		n = n.children[i]
+		search = search[len(n.prefix):]
+		if n.hasValue {
+			last = n
+		}
+	}
+	return last.key, last.value, last.hasValue
+}
+
+// Minimum returns the smallest key in the RadixTree and its value.
+// The boolean result is false if the tree is empty.
+func (t *RadixTree[V]) Minimum() (string, V, bool) {
+	n := t.root
+	// A key sorts before every longer key it is a prefix of.
+	for !n.hasValue && len(n.children) > 0 {
+		n = n.children[0]
+	}
+	return n.key, n.value, n.hasValue
+}
+
+// Maximum returns the largest key in the RadixTree and its value.
+// The boolean result is false if the tree is empty.
+func (t *RadixTree[V]) Maximum() (string, V, bool) {
+	n := t.root
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	return n.key, n.value, n.hasValue
+}
+
+// Count returns the number of keys in the RadixTree.
+func (t *RadixTree[V]) Count() int {
+	return t.size
+}
+
+// Clear removes all keys from the RadixTree. Snapshots taken earlier are unaffected.
+func (t *RadixTree[V]) Clear() {
+	t.own()
+	t.root = &radixNode[V]{gen: t.gen}
+	t.size = 0
+}
+
+// Snapshot returns an independent copy of the RadixTree in O(1).
+// Both trees share their nodes until either is modified, and then copy only the nodes on the modified paths.
+//
+// Example:
+//  tree := NewRadixTree[int]()
+//  tree.Insert("a", 1)
+//  snapshot := tree.Snapshot()
+//  tree.Insert("b", 2)
+//  fmt.Println(tree.Count(), snapshot.Count()) // Output: 2 1
+func (t *RadixTree[V]) Snapshot() *RadixTree[V] {
+	// Neither tree may modify the shared nodes in place any more: the snapshot starts with a new generation,
+	// and t takes one on its next change.
+	t.shared.Store(true)
+	return &RadixTree[V]{root: t.root, size: t.size, gen: cowGeneration.Add(1)}
+}
+
+// Txn starts a transaction against a snapshot of the RadixTree.
+// Changes made through the transaction are invisible to the tree until they are committed
+// and the committed tree is used in its place.
+func (t *RadixTree[V]) Txn() *RadixTxn[V] {
+	return &RadixTxn[V]{tree: t.Snapshot()}
+}
+
+// RadixTxn batches changes to a RadixTree without affecting the tree it was started from.
+type RadixTxn[V any] struct {
+	tree *RadixTree[V]
+}
+
+// Insert adds or updates the value for key within the transaction.
+// Returns true if the key was newly added.
+func (txn *RadixTxn[V]) Insert(key string, value V) bool {
+	return txn.tree.Insert(key, value)
+}
+
+// Delete removes key within the transaction.
+// Returns true if the key was removed, false if it was not present.
+func (txn *RadixTxn[V]) Delete(key string) bool {
+	return txn.tree.Delete(key)
+}
+
+// DeletePrefix removes every key that starts with prefix within the transaction
+// and returns how many were removed.
+func (txn *RadixTxn[V]) DeletePrefix(prefix string) int {
+	return txn.tree.DeletePrefix(prefix)
+}
+
+// Get retrieves the value for key as seen by the transaction.
+func (txn *RadixTxn[V]) Get(key string) (V, bool) {
+	return txn.tree.Get(key)
+}
+
+// Commit returns a RadixTree holding the changes made so far.
+// The transaction remains usable, and its later changes do not affect the returned tree.
+//
+// Example:
+//  txn := tree.Txn()
+//  txn.Insert("c", 3)
+//  txn.Delete("a")
+//  tree = txn.Commit()
+func (txn *RadixTxn[V]) Commit() *RadixTree[V] {
+	return txn.tree.Snapshot()
+}
+
+// RadixIterator walks the keys of a RadixTree in lexicographic order.
+// It iterates over the tree as it was when the iterator was created.
+type RadixIterator[V any] struct {
+	root *radixNode[V]
+	// stack holds the subtrees still to be visited; the last slice is visited first.
+	stack [][]*radixNode[V]
+	next  *radixNode[V]
+}
+
+// NewIterator creates a new iterator positioned at the smallest key.
+//
+// Example:
+//  it := tree.NewIterator()
+//  it.Seek("m")
+//  for it.HasNext() {
+//  	key, value, _ := it.Next()
+//  	fmt.Println(key, value)
+//  }
+func (t *RadixTree[V]) NewIterator() *RadixIterator[V] {
+	// Freeze the current nodes so that later changes to the tree copy them instead.
+	t.shared.Store(true)
+	it := &RadixIterator[V]{root: t.root}
+	it.Seek("")
+	return it
+}
+
+// Seek repositions the iterator at the smallest key greater than or equal to key.
+func (it *RadixIterator[V]) Seek(key string) {
+	it.stack = it.stack[:0]
+	n, search := it.root, key
+	for {
+		if search == "" {
+			// Every key below n is at least key.
+			it.stack = append(it.stack, []*radixNode[V]{n})
+			break
+		}
+
+		// n's own key is a proper prefix of key, so it sorts before it.
+		i, ok := n.childIndex(search[0])
+		if !ok {
+			it.stack = append(it.stack, n.children[i:])
+			break
+		}
+		it.stack = append(it.stack, n.children[i+1:])
+
+		child := n.children[i]
+		common := commonPrefixLength(child.prefix, search)
+		if common == len(search) {
+			it.stack = append(it.stack, []*radixNode[V]{child})
+			break
+		}
+		if common < len(child.prefix) {
+			if child.prefix[common] > search[common] {
+				it.stack = append(it.stack, []*radixNode[V]{child})
+			}
+			break
+		}
+		n, search = child, search[common:]
+	}
+	it.advance()
+}
+
+// advance finds the next node holding a value.
+func (it *RadixIterator[V]) advance() {
+	it.next = nil
+	for len(it.stack) > 0 {
+		top := len(it.stack) - 1
+		if len(it.stack[top]) == 0 {
+			it.stack = it.stack[:top]
+			continue
+		}
+		n := it.stack[top][0]
+		it.stack[top] = it.stack[top][1:]
+		if len(n.children) > 0 {
+			it.stack = append(it.stack, n.children)
+		}
+		if n.hasValue {
+			it.next = n
+			return
+		}
+	}
+}
+
+// HasNext checks if there are more keys to visit.
+func (it *RadixIterator[V]) HasNext() bool {
+	return it.next != nil
+}
+
+// Next returns the next key and its value in the iteration.
+func (it *RadixIterator[V]) Next() (string, V, bool) {
+	if it.next == nil {
+		var zeroValue V
+		return "", zeroValue, false
+	}
+	n := it.next
+	it.advance()
+	return n.key, n.value, true
+}
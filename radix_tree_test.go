@@ -0,0 +1,240 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func radixKeys[V any](tree *RadixTree[V], seek string) []string {
+	var keys []string
+	it := tree.NewIterator()
+	it.Seek(seek)
+	for it.HasNext() {
+		key, _, _ := it.Next()
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestRadixTree(t *testing.T) {
+	tree := NewRadixTree[int]()
+	keys := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom", ""}
+	for i, key := range keys {
+		if !tree.Insert(key, i) {
+			t.Errorf("Insert(%q) = false; want true", key)
+		}
+	}
+	if tree.Insert("rom", 100) {
+		t.Error("Insert(rom) again = true; want false")
+	}
+	if count := tree.Count(); count != len(keys) {
+		t.Errorf("Count() = %d; want %d", count, len(keys))
+	}
+
+	if v, ok := tree.Get("rom"); !ok || v != 100 {
+		t.Errorf("Get(rom) = %d, %v; want 100, true", v, ok)
+	}
+	if _, ok := tree.Get("roma"); ok {
+		t.Error("Get(roma) found a value inside an edge")
+	}
+
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	assertSliceEqual(t, radixKeys(tree, ""), sorted)
+	assertSliceEqual(t, radixKeys(tree, "romb"), []string{"romulus", "rubens", "ruber", "rubicon", "rubicundus"})
+	assertSliceEqual(t, radixKeys(tree, "rubi"), []string{"rubicon", "rubicundus"})
+	assertSliceEqual(t, radixKeys(tree, "ruber"), []string{"ruber", "rubicon", "rubicundus"})
+	if keys := radixKeys(tree, "s"); len(keys) != 0 {
+		t.Errorf("Seek(s) visited %v; want nothing", keys)
+	}
+
+	if key, _, ok := tree.Minimum(); !ok || key != "" {
+		t.Errorf("Minimum() = %q, %v; want \"\", true", key, ok)
+	}
+	if key, _, ok := tree.Maximum(); !ok || key != "rubicundus" {
+		t.Errorf("Maximum() = %q, %v; want rubicundus, true", key, ok)
+	}
+	if key, v, ok := tree.LongestPrefix("romanesque"); !ok || key != "romane" || v != 0 {
+		t.Errorf("LongestPrefix(romanesque) = %q, %d, %v; want romane, 0, true", key, v, ok)
+	}
+	if key, _, _ := tree.LongestPrefix("romanus2"); key != "romanus" {
+		t.Errorf("LongestPrefix(romanus2) = %q; want romanus", key)
+	}
+
+	if !tree.Delete("romane") || tree.Delete("romane") || tree.Delete("roma") {
+		t.Error("Delete() returned the wrong result")
+	}
+	if removed := tree.DeletePrefix("rub"); removed != 4 {
+		t.Errorf("DeletePrefix(rub) = %d; want 4", removed)
+	}
+	assertSliceEqual(t, radixKeys(tree, ""), []string{"", "rom", "romanus", "romulus"})
+	if removed := tree.DeletePrefix("x"); removed != 0 {
+		t.Errorf("DeletePrefix(x) = %d; want 0", removed)
+	}
+	if removed := tree.DeletePrefix(""); removed != 4 || tree.Count() != 0 {
+		t.Errorf("DeletePrefix(\"\") = %d, leaving %d keys", removed, tree.Count())
+	}
+	if _, _, ok := tree.Minimum(); ok {
+		t.Error("Minimum() of an empty tree returned true")
+	}
+	if _, _, ok := tree.Maximum(); ok {
+		t.Error("Maximum() of an empty tree returned true")
+	}
+}
+
+func TestRadixTree_Snapshot(t *testing.T) {
+	tree := NewRadixTree[int]()
+	tree.Insert("a", 1)
+	tree.Insert("ab", 2)
+	tree.Insert("abc", 3)
+
+	snapshot := tree.Snapshot()
+	it := tree.NewIterator()
+	tree.Insert("abd", 4)
+	tree.Insert("ab", 20)
+	tree.Delete("a")
+	snapshot.Insert("b", 5)
+
+	assertSliceEqual(t, radixKeys(tree, ""), []string{"ab", "abc", "abd"})
+	assertSliceEqual(t, radixKeys(snapshot, ""), []string{"a", "ab", "abc", "b"})
+	if v, _ := snapshot.Get("ab"); v != 2 {
+		t.Errorf("snapshot.Get(ab) = %d; want 2", v)
+	}
+
+	var visited []string
+	for it.HasNext() {
+		key, _, _ := it.Next()
+		visited = append(visited, key)
+	}
+	assertSliceEqual(t, visited, []string{"a", "ab", "abc"})
+
+	txn := tree.Txn()
+	txn.Insert("x", 9)
+	txn.DeletePrefix("ab")
+	if _, ok := txn.Get("x"); !ok || tree.ContainsKey("x") {
+		t.Error("Txn changes must be visible to the transaction only")
+	}
+	committed := txn.Commit()
+	txn.Insert("y", 10)
+	assertSliceEqual(t, radixKeys(committed, ""), []string{"x"})
+	assertSliceEqual(t, radixKeys(tree, ""), []string{"ab", "abc", "abd"})
+}
+
+func TestRadixTree_ConcurrentSnapshotReads(t *testing.T) {
+	tree := NewRadixTree[int]()
+	for i, key := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon"} {
+		tree.Insert(key, i)
+	}
+	snapshot := tree.Snapshot()
+	tree.Insert("rubicundus", 6)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				nested := snapshot.Snapshot()
+				nested.Insert("rome", i)
+				count := 0
+				for it := snapshot.NewIterator(); it.HasNext(); it.Next() {
+					count++
+				}
+				if count != 6 || nested.Count() != 7 {
+					t.Errorf("iterated %d keys and nested Count() = %d; want 6 and 7", count, nested.Count())
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count := snapshot.Count(); count != 6 || snapshot.ContainsKey("rome") {
+		t.Errorf("snapshot changed by readers: Count() = %d", count)
+	}
+}
+
+func TestRadixTree_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(17))
+	tree := NewRadixTree[int]()
+	reference := map[string]int{}
+	var snapshots []*RadixTree[int]
+	var snapshotRefs []map[string]int
+
+	randomKey := func() string {
+		b := make([]byte, r.Intn(6))
+		for i := range b {
+			b[i] = "abc/"[r.Intn(4)]
+		}
+		return string(b)
+	}
+
+	for i := 0; i < 5000; i++ {
+		key := randomKey()
+		switch r.Intn(10) {
+		case 0, 1, 2:
+			_, existed := reference[key]
+			if tree.Delete(key) != existed {
+				t.Fatalf("Delete(%q) != %v", key, existed)
+			}
+			delete(reference, key)
+		case 3:
+			if key == "" {
+				continue
+			}
+			want := 0
+			for k := range reference {
+				if strings.HasPrefix(k, key) {
+					delete(reference, k)
+					want++
+				}
+			}
+			if removed := tree.DeletePrefix(key); removed != want {
+				t.Fatalf("DeletePrefix(%q) = %d; want %d", key, removed, want)
+			}
+		case 4:
+			if len(snapshots) < 5 {
+				snapshots = append(snapshots, tree.Snapshot())
+				ref := make(map[string]int, len(reference))
+				for k, v := range reference {
+					ref[k] = v
+				}
+				snapshotRefs = append(snapshotRefs, ref)
+			}
+		default:
+			tree.Insert(key, i)
+			reference[key] = i
+		}
+	}
+
+	check := func(tree *RadixTree[int], reference map[string]int) {
+		t.Helper()
+		var want []string
+		for k := range reference {
+			want = append(want, k)
+		}
+		sort.Strings(want)
+		if tree.Count() != len(want) {
+			t.Fatalf("Count() = %d; want %d", tree.Count(), len(want))
+		}
+		assertSliceEqual(t, radixKeys(tree, ""), want)
+		for k, v := range reference {
+			if got, ok := tree.Get(k); !ok || got != v {
+				t.Fatalf("Get(%q) = %d, %v; want %d, true", k, got, ok, v)
+			}
+		}
+		seek := "b/"
+		i := sort.SearchStrings(want, seek)
+		if got := radixKeys(tree, seek); len(got) != len(want)-i {
+			t.Fatalf("Seek(%q) visited %d keys; want %d", seek, len(got), len(want)-i)
+		}
+	}
+
+	check(tree, reference)
+	for i := range snapshots {
+		check(snapshots[i], snapshotRefs[i])
+	}
+}
@@ -0,0 +1,254 @@
+package collections
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// Range is the half-open range [Lo, Hi) of ordered values.
+type Range[T any] struct {
+	Lo, Hi T
+}
+
+// RangeSet is a set of values stored as disjoint half-open ranges. Adding a range merges it with any
+// ranges it overlaps or touches, and removing one splits the ranges it cuts through,
+// so the set always holds the fewest ranges that cover its values.
+//
+// Example:
+//  ids := NewRangeSet[int]()
+//  ids.Add(0, 10)
+//  ids.Add(10, 20)
+//  ids.Remove(5, 8)
+//  fmt.Println(ids.Ranges()) // Output: [{0 5} {8 20}]
+type RangeSet[T any] struct {
+	// ranges is sorted, and consecutive ranges neither overlap nor touch.
+	ranges  []Range[T]
+	compare func(a, b T) int
+}
+
+// NewRangeSet creates a new empty RangeSet over the natural order of T.
+func NewRangeSet[T cmp.Ordered]() *RangeSet[T] {
+	return NewRangeSetFunc(cmp.Compare[T])
+}
+
+// NewRangeSetFunc creates a new empty RangeSet over values ordered by compare.
+func NewRangeSetFunc[T any](compare func(a, b T) int) *RangeSet[T] {
+	return &RangeSet[T]{compare: compare}
+}
+
+func (s *RangeSet[T]) newSet(ranges []Range[T]) *RangeSet[T] {
+	return &RangeSet[T]{ranges: ranges, compare: s.compare}
+}
+
+// search returns the index of the first range for which f is true; f must be monotonic over ranges.
+func (s *RangeSet[T]) search(f func(r Range[T]) bool) int {
+	return sort.Search(len(s.ranges), func(i int) bool { return f(s.ranges[i]) })
+}
+
+// Add adds the values in [lo, hi) to the set. An empty range is ignored.
+func (s *RangeSet[T]) Add(lo, hi T) {
+	if s.compare(lo, hi) >= 0 {
+		return
+	}
+	// Ranges i through j-1 overlap or touch [lo, hi).
+	i := s.search(func(r Range[T]) bool { return s.compare(r.Hi, lo) >= 0 })
+	j := s.search(func(r Range[T]) bool { return s.compare(r.Lo, hi) > 0 })
+	if i < j {
+		if s.compare(s.ranges[i].Lo, lo) < 0 {
+			lo = s.ranges[i].Lo
+		}
+		if s.compare(s.ranges[j-1].Hi, hi) > 0 {
+			hi = s.ranges[j-1].Hi
+		}
+	}
+	s.replace(i, j, Range[T]{Lo: lo, Hi: hi})
+}
+
+// Remove removes the values in [lo, hi) from the set. An empty range is ignored.
+func (s *RangeSet[T]) Remove(lo, hi T) {
+	if s.compare(lo, hi) >= 0 {
+		return
+	}
+	// Ranges i through j-1 overlap [lo, hi).
+	i := s.search(func(r Range[T]) bool { return s.compare(r.Hi, lo) > 0 })
+	j := s.search(func(r Range[T]) bool { return s.compare(r.Lo, hi) >= 0 })
+	if i == j {
+		return
+	}
+
+	var rest []Range[T]
+	if s.compare(s.ranges[i].Lo, lo) < 0 {
+		rest = append(rest, Range[T]{Lo: s.ranges[i].Lo, Hi: lo})
+	}
+	if s.compare(s.ranges[j-1].Hi, hi) > 0 {
+		rest = append(rest, Range[T]{Lo: hi, Hi: s.ranges[j-1].Hi})
+	}
+	s.replace(i, j, rest...)
+}
+
+// replace substitutes ranges for s.ranges[i:j].
+func (s *RangeSet[T]) replace(i, j int, ranges ...Range[T]) {
+	s.ranges = slices.Replace(s.ranges, i, j, ranges...)
+}
+
+// Contains checks if point is in the set.
+func (s *RangeSet[T]) Contains(point T) bool {
+	i := s.search(func(r Range[T]) bool { return s.compare(r.Hi, point) > 0 })
+	return i < len(s.ranges) && s.compare(s.ranges[i].Lo, point) <= 0
+}
+
+// Encloses checks if every value in [lo, hi) is in the set. An empty range is always enclosed.
+func (s *RangeSet[T]) Encloses(lo, hi T) bool {
+	if s.compare(lo, hi) >= 0 {
+		return true
+	}
+	i := s.search(func(r Range[T]) bool { return s.compare(r.Hi, lo) > 0 })
+	return i < len(s.ranges) && s.compare(s.ranges[i].Lo, lo) <= 0 && s.compare(hi, s.ranges[i].Hi) <= 0
+}
+
+// Complement returns the values in [lo, hi) that are not in the set.
+//
+// Example:
+//  used := NewRangeSet[int]()
+//  used.Add(3, 5)
+//  fmt.Println(used.Complement(0, 10).Ranges()) // Output: [{0 3} {5 10}]
+func (s *RangeSet[T]) Complement(lo, hi T) *RangeSet[T] {
+	var gaps []Range[T]
+	if s.compare(lo, hi) < 0 {
+		next := lo
+		for _, r := range s.ranges {
+			if s.compare(r.Hi, next) <= 0 {
+				continue
+			}
+			if s.compare(r.Lo, hi) >= 0 {
+				break
+			}
+			if s.compare(next, r.Lo) < 0 {
+				gaps = append(gaps, Range[T]{Lo: next, Hi: r.Lo})
+			}
+			next = r.Hi
+		}
+		if s.compare(next, hi) < 0 {
+			gaps = append(gaps, Range[T]{Lo: next, Hi: hi})
+		}
+	}
+	return s.newSet(gaps)
+}
+
+// Union returns the values that are in either set.
+func (s *RangeSet[T]) Union(other *RangeSet[T]) *RangeSet[T] {
+	var ranges []Range[T]
+	add := func(r Range[T]) {
+		if last := len(ranges) - 1; last >= 0 && s.compare(r.Lo, ranges[last].Hi) <= 0 {
+			if s.compare(r.Hi, ranges[last].Hi) > 0 {
+				ranges[last].Hi = r.Hi
+			}
+			return
+		}
+		ranges = append(ranges, r)
+	}
+
+	i, j := 0, 0
+	for i < len(s.ranges) || j < len(other.ranges) {
+		if j == len(other.ranges) || (i < len(s.ranges) && s.compare(s.ranges[i].Lo, other.ranges[j].Lo) <= 0) {
+			add(s.ranges[i])
+			i++
+		} else {
+			add(other.ranges[j])
+			j++
+		}
+	}
+	return s.newSet(ranges)
+}
+
+// Intersect returns the values that are in both sets.
+func (s *RangeSet[T]) Intersect(other *RangeSet[T]) *RangeSet[T] {
+	var ranges []Range[T]
+	i, j := 0, 0
+	for i < len(s.ranges) && j < len(other.ranges) {
+		a, b := s.ranges[i], other.ranges[j]
+		lo, hi := a.Lo, a.Hi
+		if s.compare(b.Lo, lo) > 0 {
+			lo = b.Lo
+		}
+		if s.compare(b.Hi, hi) < 0 {
+			hi = b.Hi
+		}
+		if s.compare(lo, hi) < 0 {
+			ranges = append(ranges, Range[T]{Lo: lo, Hi: hi})
+		}
+		// Advance past whichever range ends first.
+		if s.compare(a.Hi, b.Hi) <= 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return s.newSet(ranges)
+}
+
+// Difference returns the values that are in this set but not in other.
+func (s *RangeSet[T]) Difference(other *RangeSet[T]) *RangeSet[T] {
+	var ranges []Range[T]
+	j := 0
+	for _, r := range s.ranges {
+		lo := r.Lo
+		// Skip the ranges of other that end before r starts.
+		for j < len(other.ranges) && s.compare(other.ranges[j].Hi, lo) <= 0 {
+			j++
+		}
+		for k := j; k < len(other.ranges) && s.compare(other.ranges[k].Lo, r.Hi) < 0; k++ {
+			if s.compare(lo, other.ranges[k].Lo) < 0 {
+				ranges = append(ranges, Range[T]{Lo: lo, Hi: other.ranges[k].Lo})
+			}
+			if s.compare(other.ranges[k].Hi, lo) > 0 {
+				lo = other.ranges[k].Hi
+			}
+		}
+		if s.compare(lo, r.Hi) < 0 {
+			ranges = append(ranges, Range[T]{Lo: lo, Hi: r.Hi})
+		}
+	}
+	return s.newSet(ranges)
+}
+
+// Span returns the smallest range that covers the whole set.
+// The boolean result is false if the set is empty.
+func (s *RangeSet[T]) Span() (Range[T], bool) {
+	if len(s.ranges) == 0 {
+		return Range[T]{}, false
+	}
+	return Range[T]{Lo: s.ranges[0].Lo, Hi: s.ranges[len(s.ranges)-1].Hi}, true
+}
+
+// Count returns the number of disjoint ranges in the set.
+func (s *RangeSet[T]) Count() int {
+	return len(s.ranges)
+}
+
+// IsEmpty checks if the set holds no values.
+func (s *RangeSet[T]) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Clear removes all values from the set.
+func (s *RangeSet[T]) Clear() {
+	s.ranges = nil
+}
+
+// Ascend calls fn for every range in ascending order until fn returns false.
+func (s *RangeSet[T]) Ascend(fn func(r Range[T]) bool) {
+	for _, r := range s.ranges {
+		if !fn(r) {
+			return
+		}
+	}
+}
+
+// Ranges returns a slice of the disjoint ranges in the set, in ascending order.
+func (s *RangeSet[T]) Ranges() []Range[T] {
+	ranges := make([]Range[T], len(s.ranges))
+	copy(ranges, s.ranges)
+	return ranges
+}
@@ -0,0 +1,124 @@
+package collections
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRangeSet(t *testing.T) {
+	set := NewRangeSet[int]()
+	set.Add(10, 20)
+	set.Add(30, 40)
+	set.Add(20, 25) // touches [10, 20)
+	set.Add(5, 5)   // empty
+	assertSliceEqual(t, set.Ranges(), []Range[int]{{10, 25}, {30, 40}})
+
+	set.Add(24, 31)
+	assertSliceEqual(t, set.Ranges(), []Range[int]{{10, 40}})
+
+	set.Remove(15, 18)
+	set.Remove(38, 50)
+	assertSliceEqual(t, set.Ranges(), []Range[int]{{10, 15}, {18, 38}})
+
+	for point, want := range map[int]bool{9: false, 10: true, 14: true, 15: false, 17: false, 18: true, 37: true, 38: false} {
+		if got := set.Contains(point); got != want {
+			t.Errorf("Contains(%d) = %v; want %v", point, got, want)
+		}
+	}
+	if !set.Encloses(20, 38) || set.Encloses(12, 20) || set.Encloses(30, 39) || !set.Encloses(99, 99) {
+		t.Error("Encloses returned an unexpected result")
+	}
+	if span, ok := set.Span(); !ok || span != (Range[int]{10, 38}) {
+		t.Errorf("Span() = %v, %v; want {10 38}, true", span, ok)
+	}
+
+	assertSliceEqual(t, set.Complement(0, 30).Ranges(), []Range[int]{{0, 10}, {15, 18}})
+	assertSliceEqual(t, set.Complement(12, 16).Ranges(), []Range[int]{{15, 16}})
+	assertSliceEqual(t, set.Complement(20, 30).Ranges(), nil)
+
+	set.Clear()
+	if !set.IsEmpty() || set.Count() != 0 {
+		t.Error("Clear() did not empty the set")
+	}
+	if _, ok := set.Span(); ok {
+		t.Error("Span() on an empty set = true; want false")
+	}
+}
+
+func TestRangeSet_SetOperations(t *testing.T) {
+	a := NewRangeSet[int]()
+	a.Add(0, 10)
+	a.Add(20, 30)
+	b := NewRangeSet[int]()
+	b.Add(5, 20)
+	b.Add(25, 27)
+	b.Add(40, 50)
+
+	assertSliceEqual(t, a.Union(b).Ranges(), []Range[int]{{0, 30}, {40, 50}})
+	assertSliceEqual(t, a.Intersect(b).Ranges(), []Range[int]{{5, 10}, {25, 27}})
+	assertSliceEqual(t, a.Difference(b).Ranges(), []Range[int]{{0, 5}, {20, 25}, {27, 30}})
+	assertSliceEqual(t, b.Difference(a).Ranges(), []Range[int]{{10, 20}, {40, 50}})
+}
+
+func TestRangeSet_RandomOperations(t *testing.T) {
+	const bound = 200
+	r := rand.New(rand.NewSource(11))
+	randomSet := func() (*RangeSet[int], [bound]bool) {
+		set := NewRangeSet[int]()
+		var bits [bound]bool
+		for i := 0; i < 40; i++ {
+			lo := r.Intn(bound)
+			hi := lo + r.Intn(20)
+			if hi > bound {
+				hi = bound
+			}
+			add := r.Intn(3) != 0
+			if add {
+				set.Add(lo, hi)
+			} else {
+				set.Remove(lo, hi)
+			}
+			for v := lo; v < hi; v++ {
+				bits[v] = add
+			}
+		}
+		return set, bits
+	}
+	check := func(name string, set *RangeSet[int], want func(v int) bool) {
+		t.Helper()
+		prev := Range[int]{-2, -1}
+		set.Ascend(func(rg Range[int]) bool {
+			if rg.Lo >= rg.Hi || rg.Lo <= prev.Hi {
+				t.Fatalf("%s: ranges %v and %v are empty, unsorted or not coalesced", name, prev, rg)
+			}
+			prev = rg
+			return true
+		})
+		for v := -1; v <= bound; v++ {
+			if got := set.Contains(v); got != want(v) {
+				t.Fatalf("%s: Contains(%d) = %v; want %v", name, v, got, want(v))
+			}
+		}
+	}
+	inside := func(bits [bound]bool, v int) bool { return v >= 0 && v < bound && bits[v] }
+
+	for round := 0; round < 50; round++ {
+		a, aBits := randomSet()
+		b, bBits := randomSet()
+		check("a", a, func(v int) bool { return inside(aBits, v) })
+		check("union", a.Union(b), func(v int) bool { return inside(aBits, v) || inside(bBits, v) })
+		check("intersect", a.Intersect(b), func(v int) bool { return inside(aBits, v) && inside(bBits, v) })
+		check("difference", a.Difference(b), func(v int) bool { return inside(aBits, v) && !inside(bBits, v) })
+		check("complement", a.Complement(50, 150), func(v int) bool { return v >= 50 && v < 150 && !inside(aBits, v) })
+
+		lo := r.Intn(bound)
+		hi := lo + r.Intn(10)
+		want := true
+		for v := lo; v < hi; v++ {
+			want = want && inside(aBits, v)
+		}
+		if got := a.Encloses(lo, hi); got != want {
+			t.Fatalf("Encloses(%d, %d) = %v; want %v", lo, hi, got, want)
+		}
+	}
+}
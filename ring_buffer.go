@@ -0,0 +1,187 @@
+package collections
+
+import "errors"
+
+// ErrRingBufferFull is returned by RingBuffer.Push when the buffer is full and its policy is OverflowError.
+var ErrRingBufferFull = errors.New("ring buffer is full")
+
+// OverflowPolicy decides what a RingBuffer does when an element is pushed while it is full.
+type OverflowPolicy int
+
+const (
+	// OverflowOverwrite discards the oldest element to make room for the new one.
+	OverflowOverwrite OverflowPolicy = iota
+	// OverflowReject silently discards the new element.
+	OverflowReject
+	// OverflowError discards the new element and makes Push return ErrRingBufferFull.
+	OverflowError
+)
+
+// RingBuffer represents a generic fixed-capacity circular buffer.
+// Elements are kept in insertion order, from oldest to newest.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	policy OverflowPolicy
+}
+
+// NewRingBuffer creates a new RingBuffer holding at most capacity elements.
+//
+// Example:
+//  rb := NewRingBuffer[int](3, OverflowOverwrite)
+//  for i := 1; i <= 5; i++ {
+//  	rb.Push(i)
+//  }
+//  fmt.Println(rb.ToSlice()) // Output: [3 4 5]
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("Capacity must be positive.")
+	}
+
+	return &RingBuffer[T]{
+		buf:    make([]T, capacity),
+		policy: policy,
+	}
+}
+
+// Push adds an element as the newest element of the buffer, applying the overflow policy when it is full.
+// It returns ErrRingBufferFull only when the buffer is full and the policy is OverflowError.
+func (rb *RingBuffer[T]) Push(element T) error {
+	if rb.size == len(rb.buf) {
+		switch rb.policy {
+		case OverflowReject:
+			return nil
+		case OverflowError:
+			return ErrRingBufferFull
+		}
+		rb.buf[rb.head] = element
+		rb.head = (rb.head + 1) % len(rb.buf)
+		return nil
+	}
+
+	rb.buf[(rb.head+rb.size)%len(rb.buf)] = element
+	rb.size++
+	return nil
+}
+
+// Pop removes and returns the oldest element of the buffer.
+// It panics if the buffer is empty.
+func (rb *RingBuffer[T]) Pop() T {
+	if rb.size == 0 {
+		panic("Pop from an empty ring buffer")
+	}
+
+	var zeroValue T
+	element := rb.buf[rb.head]
+	rb.buf[rb.head] = zeroValue
+	rb.head = (rb.head + 1) % len(rb.buf)
+	rb.size--
+	return element
+}
+
+// Peek returns the oldest element of the buffer without removing it.
+// It panics if the buffer is empty.
+func (rb *RingBuffer[T]) Peek() T {
+	if rb.size == 0 {
+		panic("Peek from an empty ring buffer")
+	}
+
+	return rb.buf[rb.head]
+}
+
+// PeekNewest returns the newest element of the buffer without removing it.
+// It panics if the buffer is empty.
+func (rb *RingBuffer[T]) PeekNewest() T {
+	if rb.size == 0 {
+		panic("Peek from an empty ring buffer")
+	}
+
+	return rb.buf[(rb.head+rb.size-1)%len(rb.buf)]
+}
+
+// Get retrieves the element at the specified index, where 0 is the oldest element.
+func (rb *RingBuffer[T]) Get(index int) T {
+	if index < 0 || index >= rb.size {
+		panic("Index out of range.")
+	}
+	return rb.buf[(rb.head+index)%len(rb.buf)]
+}
+
+// GetFromNewest retrieves the element at the specified index, where 0 is the newest element.
+func (rb *RingBuffer[T]) GetFromNewest(index int) T {
+	if index < 0 || index >= rb.size {
+		panic("Index out of range.")
+	}
+	return rb.buf[(rb.head+rb.size-1-index)%len(rb.buf)]
+}
+
+// Size returns the number of elements in the buffer.
+func (rb *RingBuffer[T]) Size() int {
+	return rb.size
+}
+
+// Capacity returns the maximum number of elements the buffer can hold.
+func (rb *RingBuffer[T]) Capacity() int {
+	return len(rb.buf)
+}
+
+// IsEmpty returns true if the buffer is empty, false otherwise.
+func (rb *RingBuffer[T]) IsEmpty() bool {
+	return rb.size == 0
+}
+
+// IsFull returns true if the buffer holds Capacity elements, false otherwise.
+func (rb *RingBuffer[T]) IsFull() bool {
+	return rb.size == len(rb.buf)
+}
+
+// Policy returns the overflow policy of the buffer.
+func (rb *RingBuffer[T]) Policy() OverflowPolicy {
+	return rb.policy
+}
+
+// Clear removes all elements from the buffer.
+func (rb *RingBuffer[T]) Clear() {
+	var zeroValue T
+	for i := range rb.buf {
+		rb.buf[i] = zeroValue
+	}
+	rb.head = 0
+	rb.size = 0
+}
+
+// ToSlice returns the elements of the buffer from oldest to newest.
+func (rb *RingBuffer[T]) ToSlice() []T {
+	slice := make([]T, rb.size)
+	n := copy(slice, rb.buf[rb.head:min(rb.head+rb.size, len(rb.buf))])
+	copy(slice[n:], rb.buf[:rb.size-n])
+	return slice
+}
+
+// RingBufferIterator represents an iterator over a RingBuffer from oldest to newest.
+type RingBufferIterator[T any] struct {
+	buffer *RingBuffer[T]
+	index  int
+}
+
+// NewIterator creates a new iterator that walks the buffer from oldest to newest.
+func (rb *RingBuffer[T]) NewIterator() *RingBufferIterator[T] {
+	return &RingBufferIterator[T]{buffer: rb, index: 0}
+}
+
+// HasNext checks if there are more elements to iterate over.
+func (it *RingBufferIterator[T]) HasNext() bool {
+	return it.index < it.buffer.Size()
+}
+
+// Next returns the next element in the iteration.
+func (it *RingBufferIterator[T]) Next() (T, bool) {
+	if it.HasNext() {
+		item := it.buffer.Get(it.index)
+		it.index++
+		return item, true
+	}
+	var zeroValue T
+	return zeroValue, false
+}
@@ -0,0 +1,79 @@
+package collections
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRingBuffer_Overwrite(t *testing.T) {
+	rb := NewRingBuffer[int](3, OverflowOverwrite)
+	for i := 1; i <= 5; i++ {
+		if err := rb.Push(i); err != nil {
+			t.Fatalf("Push(%d) = %v; want nil", i, err)
+		}
+	}
+
+	assertSliceEqual(t, rb.ToSlice(), []int{3, 4, 5})
+	if !rb.IsFull() {
+		t.Error("IsFull() = false; want true")
+	}
+	if v := rb.Get(0); v != 3 {
+		t.Errorf("Get(0) = %d; want 3", v)
+	}
+	if v := rb.GetFromNewest(0); v != 5 {
+		t.Errorf("GetFromNewest(0) = %d; want 5", v)
+	}
+	if v := rb.GetFromNewest(2); v != 3 {
+		t.Errorf("GetFromNewest(2) = %d; want 3", v)
+	}
+	if v := rb.Peek(); v != 3 {
+		t.Errorf("Peek() = %d; want 3", v)
+	}
+	if v := rb.PeekNewest(); v != 5 {
+		t.Errorf("PeekNewest() = %d; want 5", v)
+	}
+
+	if v := rb.Pop(); v != 3 {
+		t.Errorf("Pop() = %d; want 3", v)
+	}
+	rb.Push(6)
+	rb.Push(7)
+	assertSliceEqual(t, rb.ToSlice(), []int{5, 6, 7})
+
+	var iterated []int
+	for it := rb.NewIterator(); it.HasNext(); {
+		item, _ := it.Next()
+		iterated = append(iterated, item)
+	}
+	assertSliceEqual(t, iterated, []int{5, 6, 7})
+
+	rb.Clear()
+	if !rb.IsEmpty() {
+		t.Error("IsEmpty() after Clear() = false; want true")
+	}
+}
+
+func TestRingBuffer_RejectAndError(t *testing.T) {
+	reject := NewRingBuffer[int](2, OverflowReject)
+	reject.Push(1)
+	reject.Push(2)
+	if err := reject.Push(3); err != nil {
+		t.Errorf("Push() with OverflowReject = %v; want nil", err)
+	}
+	assertSliceEqual(t, reject.ToSlice(), []int{1, 2})
+
+	strict := NewRingBuffer[int](2, OverflowError)
+	strict.Push(1)
+	strict.Push(2)
+	if err := strict.Push(3); !errors.Is(err, ErrRingBufferFull) {
+		t.Errorf("Push() with OverflowError = %v; want ErrRingBufferFull", err)
+	}
+	assertSliceEqual(t, strict.ToSlice(), []int{1, 2})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for index out of range, but did not panic")
+		}
+	}()
+	strict.Get(2)
+}
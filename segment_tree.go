@@ -0,0 +1,286 @@
+package collections
+
+// SegmentTree holds a fixed number of values and answers aggregate queries over any index range
+// in O(log n), for an associative combine function such as sum, min, max or gcd. identity must
+// leave every value unchanged when combined with it, such as 0 for sum or +Inf for min.
+// combine need not be commutative; values are always combined in index order.
+//
+// Example:
+//  prices := NewArrayListT(7, 3, 9, 4)
+//  lowest := NewSegmentTreeFrom(prices.Items(), math.MaxInt, func(a, b int) int { return min(a, b) })
+//  fmt.Println(lowest.Query(1, 4)) // Output: 3
+//  lowest.Set(3, 1)
+//  fmt.Println(lowest.Query(2, 4)) // Output: 1
+type SegmentTree[T any] struct {
+	// tree[n+i] holds value i and tree[i] combines tree[2i] and tree[2i+1].
+	tree     []T
+	n        int
+	identity T
+	combine  func(a, b T) T
+}
+
+// NewSegmentTree creates a new SegmentTree of n identity values.
+func NewSegmentTree[T any](n int, identity T, combine func(a, b T) T) *SegmentTree[T] {
+	tree := make([]T, 2*n)
+	for i := range tree {
+		tree[i] = identity
+	}
+	return &SegmentTree[T]{tree: tree, n: n, identity: identity, combine: combine}
+}
+
+// NewSegmentTreeFrom creates a SegmentTree holding the values in items.
+// The tree is built in O(n).
+func NewSegmentTreeFrom[T any](items []T, identity T, combine func(a, b T) T) *SegmentTree[T] {
+	n := len(items)
+	s := &SegmentTree[T]{tree: make([]T, 2*n), n: n, identity: identity, combine: combine}
+	copy(s.tree[n:], items)
+	for i := n - 1; i > 0; i-- {
+		s.tree[i] = combine(s.tree[2*i], s.tree[2*i+1])
+	}
+	return s
+}
+
+// Count returns the number of values in the tree.
+func (s *SegmentTree[T]) Count() int {
+	return s.n
+}
+
+// Get returns the value at index.
+// It panics if index is out of range.
+func (s *SegmentTree[T]) Get(index int) T {
+	if index < 0 || index >= s.n {
+		panic("Index out of range.")
+	}
+	return s.tree[s.n+index]
+}
+
+// Set replaces the value at index in O(log n).
+// It panics if index is out of range.
+func (s *SegmentTree[T]) Set(index int, value T) {
+	if index < 0 || index >= s.n {
+		panic("Index out of range.")
+	}
+	i := s.n + index
+	s.tree[i] = value
+	for i /= 2; i > 0; i /= 2 {
+		s.tree[i] = s.combine(s.tree[2*i], s.tree[2*i+1])
+	}
+}
+
+// Query returns the values in the half-open index range [lo, hi) combined in order, in O(log n).
+// An empty range yields identity.
+// It panics if the range is out of bounds or lo is greater than hi.
+func (s *SegmentTree[T]) Query(lo, hi int) T {
+	if lo < 0 || hi > s.n || lo > hi {
+		panic("Index out of range.")
+	}
+	// Aggregate from both ends, keeping the left and right results apart to preserve order.
+	left, right := s.identity, s.identity
+	for lo, hi = lo+s.n, hi+s.n; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo&1 == 1 {
+			left = s.combine(left, s.tree[lo])
+			lo++
+		}
+		if hi&1 == 1 {
+			hi--
+			right = s.combine(s.tree[hi], right)
+		}
+	}
+	return s.combine(left, right)
+}
+
+// Items returns a slice of all values in index order.
+func (s *SegmentTree[T]) Items() []T {
+	items := make([]T, s.n)
+	copy(items, s.tree[s.n:])
+	return items
+}
+
+// LazySegmentTree is a SegmentTree that also applies an update to every value in an index range
+// in O(log n), by deferring updates on whole subtrees until they are visited.
+//
+// apply returns an aggregate of length values after update has been applied to each of them,
+// and compose returns the single update equivalent to applying older and then newer.
+//
+// Example:
+//  // Range add with range sum.
+//  totals := NewLazySegmentTreeFrom([]int{1, 2, 3, 4}, 0,
+//  	func(a, b int) int { return a + b },
+//  	func(sum, add, length int) int { return sum + add*length },
+//  	func(older, newer int) int { return older + newer })
+//  totals.RangeUpdate(1, 3, 10)
+//  fmt.Println(totals.Query(0, 4)) // Output: 30
+type LazySegmentTree[T any, U any] struct {
+	// agg[node] aggregates the node's range, including updates pending on the node itself.
+	agg      []T
+	lazy     []U
+	pending  []bool
+	n        int
+	identity T
+	combine  func(a, b T) T
+	apply    func(agg T, update U, length int) T
+	compose  func(older, newer U) U
+}
+
+// NewLazySegmentTreeFrom creates a LazySegmentTree holding the values in items.
+// The tree is built in O(n).
+func NewLazySegmentTreeFrom[T any, U any](
+	items []T,
+	identity T,
+	combine func(a, b T) T,
+	apply func(agg T, update U, length int) T,
+	compose func(older, newer U) U,
+) *LazySegmentTree[T, U] {
+	n := len(items)
+	s := &LazySegmentTree[T, U]{
+		agg:      make([]T, 4*n),
+		lazy:     make([]U, 4*n),
+		pending:  make([]bool, 4*n),
+		n:        n,
+		identity: identity,
+		combine:  combine,
+		apply:    apply,
+		compose:  compose,
+	}
+	if n > 0 {
+		s.build(1, 0, n, items)
+	}
+	return s
+}
+
+// build fills the node covering [lo, hi) from items.
+func (s *LazySegmentTree[T, U]) build(node, lo, hi int, items []T) {
+	if hi-lo == 1 {
+		s.agg[node] = items[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	s.build(2*node, lo, mid, items)
+	s.build(2*node+1, mid, hi, items)
+	s.agg[node] = s.combine(s.agg[2*node], s.agg[2*node+1])
+}
+
+// updateNode applies update to the node covering length values and records it for the node's children.
+func (s *LazySegmentTree[T, U]) updateNode(node, length int, update U) {
+	s.agg[node] = s.apply(s.agg[node], update, length)
+	if s.pending[node] {
+		s.lazy[node] = s.compose(s.lazy[node], update)
+	} else {
+		s.lazy[node] = update
+		s.pending[node] = true
+	}
+}
+
+// push hands the node's pending update down to its children, which cover [lo, mid) and [mid, hi).
+func (s *LazySegmentTree[T, U]) push(node, lo, mid, hi int) {
+	if !s.pending[node] {
+		return
+	}
+	s.updateNode(2*node, mid-lo, s.lazy[node])
+	s.updateNode(2*node+1, hi-mid, s.lazy[node])
+	var zeroValue U
+	s.lazy[node] = zeroValue
+	s.pending[node] = false
+}
+
+// Count returns the number of values in the tree.
+func (s *LazySegmentTree[T, U]) Count() int {
+	return s.n
+}
+
+// Get returns the value at index in O(log n).
+// It panics if index is out of range.
+func (s *LazySegmentTree[T, U]) Get(index int) T {
+	if index < 0 || index >= s.n {
+		panic("Index out of range.")
+	}
+	return s.Query(index, index+1)
+}
+
+// Set replaces the value at index in O(log n).
+// It panics if index is out of range.
+func (s *LazySegmentTree[T, U]) Set(index int, value T) {
+	if index < 0 || index >= s.n {
+		panic("Index out of range.")
+	}
+	s.set(1, 0, s.n, index, value)
+}
+
+func (s *LazySegmentTree[T, U]) set(node, lo, hi, index int, value T) {
+	if hi-lo == 1 {
+		s.agg[node] = value
+		return
+	}
+	mid := (lo + hi) / 2
+	s.push(node, lo, mid, hi)
+	if index < mid {
+		s.set(2*node, lo, mid, index, value)
+	} else {
+		s.set(2*node+1, mid, hi, index, value)
+	}
+	s.agg[node] = s.combine(s.agg[2*node], s.agg[2*node+1])
+}
+
+// Query returns the values in the half-open index range [lo, hi) combined in order, in O(log n).
+// An empty range yields identity.
+// It panics if the range is out of bounds or lo is greater than hi.
+func (s *LazySegmentTree[T, U]) Query(lo, hi int) T {
+	if lo < 0 || hi > s.n || lo > hi {
+		panic("Index out of range.")
+	}
+	if lo == hi {
+		return s.identity
+	}
+	return s.query(1, 0, s.n, lo, hi)
+}
+
+func (s *LazySegmentTree[T, U]) query(node, nodeLo, nodeHi, lo, hi int) T {
+	if lo <= nodeLo && nodeHi <= hi {
+		return s.agg[node]
+	}
+	mid := (nodeLo + nodeHi) / 2
+	s.push(node, nodeLo, mid, nodeHi)
+	switch {
+	case hi <= mid:
+		return s.query(2*node, nodeLo, mid, lo, hi)
+	case lo >= mid:
+		return s.query(2*node+1, mid, nodeHi, lo, hi)
+	default:
+		return s.combine(s.query(2*node, nodeLo, mid, lo, hi), s.query(2*node+1, mid, nodeHi, lo, hi))
+	}
+}
+
+// RangeUpdate applies update to every value in the half-open index range [lo, hi) in O(log n).
+// It panics if the range is out of bounds or lo is greater than hi.
+func (s *LazySegmentTree[T, U]) RangeUpdate(lo, hi int, update U) {
+	if lo < 0 || hi > s.n || lo > hi {
+		panic("Index out of range.")
+	}
+	if lo < hi {
+		s.rangeUpdate(1, 0, s.n, lo, hi, update)
+	}
+}
+
+func (s *LazySegmentTree[T, U]) rangeUpdate(node, nodeLo, nodeHi, lo, hi int, update U) {
+	if hi <= nodeLo || nodeHi <= lo {
+		return
+	}
+	if lo <= nodeLo && nodeHi <= hi {
+		s.updateNode(node, nodeHi-nodeLo, update)
+		return
+	}
+	mid := (nodeLo + nodeHi) / 2
+	s.push(node, nodeLo, mid, nodeHi)
+	s.rangeUpdate(2*node, nodeLo, mid, lo, hi, update)
+	s.rangeUpdate(2*node+1, mid, nodeHi, lo, hi, update)
+	s.agg[node] = s.combine(s.agg[2*node], s.agg[2*node+1])
+}
+
+// Items returns a slice of all values in index order.
+func (s *LazySegmentTree[T, U]) Items() []T {
+	items := make([]T, s.n)
+	for i := range items {
+		items[i] = s.Get(i)
+	}
+	return items
+}
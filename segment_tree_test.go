@@ -0,0 +1,104 @@
+package collections
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func TestSegmentTree(t *testing.T) {
+	lowest := NewSegmentTreeFrom(NewArrayListT(7, 3, 9, 4, 6).Items(), math.MaxInt, func(a, b int) int { return min(a, b) })
+	if v := lowest.Query(2, 5); v != 4 {
+		t.Errorf("Query(2, 5) = %d; want 4", v)
+	}
+	if v := lowest.Query(2, 2); v != math.MaxInt {
+		t.Errorf("Query(2, 2) = %d; want identity", v)
+	}
+	lowest.Set(4, 1)
+	if v := lowest.Query(0, 5); v != 1 || lowest.Get(4) != 1 {
+		t.Errorf("Query(0, 5) = %d; want 1", v)
+	}
+
+	divisors := NewSegmentTreeFrom([]int{12, 18, 24, 36}, 0, gcd)
+	if v := divisors.Query(0, 4); v != 6 {
+		t.Errorf("gcd Query(0, 4) = %d; want 6", v)
+	}
+
+	// String concatenation is not commutative, so this checks that values are combined in order.
+	words := NewSegmentTree(5, "", func(a, b string) string { return a + b })
+	for i, w := range []string{"a", "b", "c", "d", "e"} {
+		words.Set(i, w)
+	}
+	if v := words.Query(1, 5); v != "bcde" {
+		t.Errorf("Query(1, 5) = %q; want %q", v, "bcde")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for an out of range Query, but did not panic")
+		}
+	}()
+	words.Query(3, 6)
+}
+
+func TestLazySegmentTree(t *testing.T) {
+	totals := NewLazySegmentTreeFrom([]int{1, 2, 3, 4}, 0,
+		func(a, b int) int { return a + b },
+		func(sum, add, length int) int { return sum + add*length },
+		func(older, newer int) int { return older + newer })
+	totals.RangeUpdate(1, 3, 10)
+	if v := totals.Query(0, 4); v != 30 {
+		t.Errorf("Query(0, 4) = %d; want 30", v)
+	}
+	totals.Set(2, 0)
+	totals.RangeUpdate(0, 4, 1)
+	assertSliceEqual(t, totals.Items(), []int{2, 13, 1, 5})
+}
+
+func TestLazySegmentTree_RandomOperations(t *testing.T) {
+	// Range assignment with range max: an update replaces every value, so the newer update wins.
+	r := rand.New(rand.NewSource(5))
+	reference := make([]int, 100)
+	for i := range reference {
+		reference[i] = r.Intn(1000)
+	}
+	tree := NewLazySegmentTreeFrom(reference, math.MinInt,
+		func(a, b int) int { return max(a, b) },
+		func(_, value, _ int) int { return value },
+		func(_, newer int) int { return newer })
+
+	for i := 0; i < 3000; i++ {
+		lo := r.Intn(len(reference) + 1)
+		hi := lo + r.Intn(len(reference)+1-lo)
+		switch r.Intn(3) {
+		case 0:
+			value := r.Intn(1000)
+			tree.RangeUpdate(lo, hi, value)
+			for j := lo; j < hi; j++ {
+				reference[j] = value
+			}
+		case 1:
+			if lo < len(reference) {
+				value := r.Intn(1000)
+				tree.Set(lo, value)
+				reference[lo] = value
+			}
+		default:
+			want := math.MinInt
+			for _, v := range reference[lo:hi] {
+				want = max(want, v)
+			}
+			if got := tree.Query(lo, hi); got != want {
+				t.Fatalf("Query(%d, %d) = %d; want %d", lo, hi, got, want)
+			}
+		}
+	}
+	assertSliceEqual(t, tree.Items(), reference)
+}
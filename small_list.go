@@ -0,0 +1,43 @@
+package collections
+
+// smallCapacity is the number of items SmallList and SmallSet store inline before spilling to the heap.
+const smallCapacity = 8
+
+// SmallList is a List that stores up to eight items inline in the struct,
+// so building a short list costs a single allocation. It moves its items to a heap slice
+// once it grows beyond that, and exposes the same methods as List.
+// A SmallList must be created with NewSmallList and must not be copied by value.
+type SmallList[T comparable] struct {
+	List[T]
+	inline [smallCapacity]T
+}
+
+// NewSmallList initializes a new SmallList with the given items.
+//
+// Example:
+//  list := NewSmallList(1, 2, 3)
+//  list.Add(4)
+//  fmt.Println(list.Items()) // Output: [1 2 3 4]
+func NewSmallList[T comparable](items ...T) *SmallList[T] {
+	l := &SmallList[T]{}
+	// The comparer is left nil: List compares items with == and never consults it.
+	l.collection = l.inline[:0:smallCapacity]
+	l.AddRange(items)
+	return l
+}
+
+// Clear removes all items from the SmallList and returns it to its inline storage.
+//
+// Example:
+//  list := NewSmallList(1, 2, 3)
+//  list.Clear()
+//  fmt.Println(list.Items()) // Output: []
+func (l *SmallList[T]) Clear() {
+	l.inline = [smallCapacity]T{}
+	l.collection = l.inline[:0:smallCapacity]
+}
+
+// IsInline reports whether the SmallList's items are still stored inline.
+func (l *SmallList[T]) IsInline() bool {
+	return cap(l.collection) == smallCapacity && &l.collection[:1][0] == &l.inline[0]
+}
@@ -0,0 +1,45 @@
+package collections
+
+import "testing"
+
+func TestSmallList_SharedBehaviour(t *testing.T) {
+	testSharedList(t, func(items ...int) sharedList { return NewSmallList(items...) })
+}
+
+func TestSmallList_Spill(t *testing.T) {
+	list := NewSmallList[int]()
+	for i := 0; i < smallCapacity; i++ {
+		list.Add(i)
+	}
+	if !list.IsInline() {
+		t.Fatal("IsInline() = false; want true")
+	}
+
+	list.Add(smallCapacity)
+	if list.IsInline() {
+		t.Error("IsInline() = true after spilling; want false")
+	}
+	if !list.Remove(0) || list.GetIndex(smallCapacity) != smallCapacity-1 {
+		t.Errorf("Items() = %v after Remove(0)", list.Items())
+	}
+
+	list.Clear()
+	if !list.IsInline() || list.Count() != 0 {
+		t.Errorf("Clear() left %v, inline = %v", list.Items(), list.IsInline())
+	}
+	list.Add(7)
+	assertSliceEqual(t, list.Items(), []int{7})
+}
+
+func TestSmallList_Allocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		list := NewSmallList[int]()
+		for i := 0; i < smallCapacity; i++ {
+			list.Add(i)
+		}
+		list.Remove(3)
+	})
+	if allocs > 1 {
+		t.Errorf("SmallList with %d items made %v allocations; want at most 1", smallCapacity, allocs)
+	}
+}
@@ -0,0 +1,128 @@
+package collections
+
+// SmallSet is a set of unique items that stores up to eight items inline in the struct
+// and only allocates a map once it grows beyond that. It exposes the same methods as HashSet.
+// Inline lookups are linear scans, which beat hashing for so few items.
+type SmallSet[T comparable] struct {
+	inline [smallCapacity]T
+	count  int
+	spill  map[T]struct{}
+}
+
+// NewSmallSet initializes a new SmallSet with the given items.
+//
+// Example:
+//  set := NewSmallSet("a", "b", "a")
+//  fmt.Println(set.Count()) // Output: 2
+func NewSmallSet[T comparable](items ...T) *SmallSet[T] {
+	s := &SmallSet[T]{}
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add adds an item to the SmallSet.
+// Returns true if the item was added, false if it was already present.
+func (s *SmallSet[T]) Add(item T) bool {
+	if s.spill != nil {
+		if _, exists := s.spill[item]; exists {
+			return false
+		}
+		s.spill[item] = struct{}{}
+		return true
+	}
+
+	if s.indexOf(item) >= 0 {
+		return false
+	}
+	if s.count < smallCapacity {
+		s.inline[s.count] = item
+		s.count++
+		return true
+	}
+
+	s.spill = make(map[T]struct{}, 2*smallCapacity)
+	for _, v := range s.inline {
+		s.spill[v] = struct{}{}
+	}
+	s.spill[item] = struct{}{}
+	s.inline = [smallCapacity]T{}
+	s.count = 0
+	return true
+}
+
+// Remove removes an item from the SmallSet.
+// Returns true if the item was removed, false if it was not present.
+func (s *SmallSet[T]) Remove(item T) bool {
+	if s.spill != nil {
+		if _, exists := s.spill[item]; !exists {
+			return false
+		}
+		delete(s.spill, item)
+		return true
+	}
+
+	i := s.indexOf(item)
+	if i < 0 {
+		return false
+	}
+	s.count--
+	s.inline[i] = s.inline[s.count]
+	var zeroValue T
+	s.inline[s.count] = zeroValue
+	return true
+}
+
+// Contains checks if an item is present in the SmallSet.
+// Returns true if the item is present, false otherwise.
+func (s *SmallSet[T]) Contains(item T) bool {
+	if s.spill != nil {
+		_, exists := s.spill[item]
+		return exists
+	}
+	return s.indexOf(item) >= 0
+}
+
+// Count returns the number of items in the SmallSet.
+func (s *SmallSet[T]) Count() int {
+	if s.spill != nil {
+		return len(s.spill)
+	}
+	return s.count
+}
+
+// Clear removes all items from the SmallSet and returns it to its inline storage.
+func (s *SmallSet[T]) Clear() {
+	s.inline = [smallCapacity]T{}
+	s.count = 0
+	s.spill = nil
+}
+
+// Items returns a slice of all items in the SmallSet.
+func (s *SmallSet[T]) Items() []T {
+	if s.spill != nil {
+		items := make([]T, 0, len(s.spill))
+		for item := range s.spill {
+			items = append(items, item)
+		}
+		return items
+	}
+	items := make([]T, s.count)
+	copy(items, s.inline[:s.count])
+	return items
+}
+
+// IsInline reports whether the SmallSet's items are still stored inline.
+func (s *SmallSet[T]) IsInline() bool {
+	return s.spill == nil
+}
+
+func (s *SmallSet[T]) indexOf(item T) int {
+	for i := 0; i < s.count; i++ {
+		if s.inline[i] == item {
+			return i
+		}
+	}
+	return -1
+}
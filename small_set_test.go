@@ -0,0 +1,64 @@
+package collections
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSmallSet(t *testing.T) {
+	set := NewSmallSet[int]()
+	for i := 0; i < 2*smallCapacity; i++ {
+		if !set.Add(i) {
+			t.Errorf("Add(%d) = false; want true", i)
+		}
+		if set.Add(i) {
+			t.Errorf("Add(%d) = true for a duplicate; want false", i)
+		}
+		if inline := set.IsInline(); inline != (i < smallCapacity) {
+			t.Errorf("IsInline() = %v with %d items", inline, i+1)
+		}
+	}
+	if count := set.Count(); count != 2*smallCapacity {
+		t.Errorf("Count() = %d; want %d", count, 2*smallCapacity)
+	}
+
+	for i := 0; i < 2*smallCapacity; i += 2 {
+		if !set.Remove(i) {
+			t.Errorf("Remove(%d) = false; want true", i)
+		}
+	}
+	if set.Remove(0) || set.Contains(0) || !set.Contains(1) {
+		t.Error("Remove() left the set in the wrong state")
+	}
+	items := set.Items()
+	sort.Ints(items)
+	assertSliceEqual(t, items, []int{1, 3, 5, 7, 9, 11, 13, 15})
+
+	set.Clear()
+	if set.Count() != 0 || !set.IsInline() {
+		t.Errorf("Clear() left %v", set.Items())
+	}
+}
+
+func TestSmallSet_Inline(t *testing.T) {
+	set := NewSmallSet("a", "b", "c", "b")
+	if !set.Remove("a") || set.Remove("a") {
+		t.Error("Remove(a) twice did not return true, false")
+	}
+	set.Add("d")
+	items := set.Items()
+	sort.Strings(items)
+	assertSliceEqual(t, items, []string{"b", "c", "d"})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		s := NewSmallSet[int]()
+		for i := 0; i < smallCapacity; i++ {
+			s.Add(i)
+		}
+		s.Contains(3)
+		s.Remove(5)
+	})
+	if allocs > 1 {
+		t.Errorf("SmallSet with %d items made %v allocations; want at most 1", smallCapacity, allocs)
+	}
+}
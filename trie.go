@@ -0,0 +1,268 @@
+package collections
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Trie is a prefix tree mapping string keys to values of type V.
+// A Trie created with NewTrie branches on Unicode code points, while one created with NewByteTrie
+// branches on bytes and can hold arbitrary binary keys. Keys are visited in lexicographic byte order,
+// which for valid UTF-8 is also code point order; see NewTrie for how a rune trie orders invalid UTF-8.
+type Trie[V any] struct {
+	root   trieNode[V]
+	count  int
+	byRune bool
+}
+
+type trieNode[V any] struct {
+	// children is kept sorted by label so that walks visit keys in order.
+	children []trieEdge[V]
+	value    V
+	hasValue bool
+}
+
+type trieEdge[V any] struct {
+	label rune
+	node  *trieNode[V]
+}
+
+// NewTrie creates a new empty Trie that branches on runes.
+// Bytes that are not part of valid UTF-8 are kept as single raw bytes, so keys with different invalid bytes
+// never collide and are returned unchanged. Such bytes are visited after every code point at the same position.
+//
+// Example:
+//  trie := NewTrie[int]()
+//  trie.Insert("tea", 1)
+//  trie.Insert("ten", 2)
+//  fmt.Println(trie.KeysWithPrefix("te", 0)) // Output: [tea ten]
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{byRune: true}
+}
+
+// NewByteTrie creates a new empty Trie that branches on bytes.
+func NewByteTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+// invalidByteLabel is added to a byte that is not valid UTF-8 to label it in a rune trie,
+// placing it above every code point so it cannot collide with one.
+const invalidByteLabel = utf8.MaxRune + 1
+
+// symbol returns the label starting at key[i] and its width in bytes.
+func (t *Trie[V]) symbol(key string, i int) (rune, int) {
+	if !t.byRune {
+		return rune(key[i]), 1
+	}
+	r, width := utf8.DecodeRuneInString(key[i:])
+	if r == utf8.RuneError && width == 1 {
+		return invalidByteLabel + rune(key[i]), 1
+	}
+	return r, width
+}
+
+// appendSymbol appends the encoding of label to key.
+func (t *Trie[V]) appendSymbol(key []byte, label rune) []byte {
+	if !t.byRune {
+		return append(key, byte(label))
+	}
+	if label >= invalidByteLabel {
+		return append(key, byte(label-invalidByteLabel))
+	}
+	return utf8.AppendRune(key, label)
+}
+
+func (n *trieNode[V]) child(label rune) (int, bool) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].label >= label })
+	return i, i < len(n.children) && n.children[i].label == label
+}
+
+// find returns the node reached by following key, or nil if there is none.
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := &t.root
+	for i := 0; i < len(key); {
+		label, width := t.symbol(key, i)
+		c, ok := n.child(label)
+		if !ok {
+			return nil
+		}
+		n = n.children[c].node
+		i += width
+	}
+	return n
+}
+
+// Insert adds or updates the value for key.
+// Returns true if the key was newly added.
+func (t *Trie[V]) Insert(key string, value V) bool {
+	n := &t.root
+	for i := 0; i < len(key); {
+		label, width := t.symbol(key, i)
+		c, ok := n.child(label)
+		if !ok {
+			n.children = append(n.children, trieEdge[V]{})
+			copy(n.children[c+1:], n.children[c:])
+			n.children[c] = trieEdge[V]{label: label, node: &trieNode[V]{}}
+		}
+		n = n.children[c].node
+		i += width
+	}
+
+	added := !n.hasValue
+	n.value = value
+	n.hasValue = true
+	if added {
+		t.count++
+	}
+	return added
+}
+
+// Get retrieves the value for key.
+// Returns the value and true if the key exists, otherwise the zero value and false.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// ContainsKey checks if the Trie contains key.
+func (t *Trie[V]) ContainsKey(key string) bool {
+	n := t.find(key)
+	return n != nil && n.hasValue
+}
+
+// Delete removes key from the Trie, pruning nodes that no longer lead to any key.
+// Returns true if the key was removed, false if it was not present.
+func (t *Trie[V]) Delete(key string) bool {
+	type step struct {
+		parent *trieNode[V]
+		index  int
+	}
+	var path []step
+
+	n := &t.root
+	for i := 0; i < len(key); {
+		label, width := t.symbol(key, i)
+		c, ok := n.child(label)
+		if !ok {
+			return false
+		}
+		path = append(path, step{parent: n, index: c})
+		n = n.children[c].node
+		i += width
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	var zeroValue V
+	n.value = zeroValue
+	n.hasValue = false
+	t.count--
+
+	for j := len(path) - 1; j >= 0 && !n.hasValue && len(n.children) == 0; j-- {
+		n = path[j].parent
+		last := len(n.children) - 1
+		copy(n.children[path[j].index:], n.children[path[j].index+1:])
+		n.children[last] = trieEdge[V]{}
+		n.children = n.children[:last]
+	}
+	return true
+}
+
+// HasPrefix checks if any key in the Trie starts with prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	n := t.find(prefix)
+	// Pruning on Delete guarantees that every remaining node leads to a key.
+	return n != nil && (n.hasValue || len(n.children) > 0)
+}
+
+// KeysWithPrefix returns the keys that start with prefix in lexicographic order.
+// At most limit keys are returned; a limit of zero or less returns them all.
+//
+// Example:
+//  trie := NewTrie[bool]()
+//  trie.Insert("car", true)
+//  trie.Insert("cart", true)
+//  trie.Insert("cat", true)
+//  fmt.Println(trie.KeysWithPrefix("ca", 2)) // Output: [car cart]
+func (t *Trie[V]) KeysWithPrefix(prefix string, limit int) []string {
+	var keys []string
+	t.WalkPrefix(prefix, func(key string, _ V) bool {
+		keys = append(keys, key)
+		return limit <= 0 || len(keys) < limit
+	})
+	return keys
+}
+
+// WalkPrefix calls fn for every key that starts with prefix, in lexicographic order,
+// until fn returns false.
+func (t *Trie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	n := &t.root
+	var key []byte
+	for i := 0; i < len(prefix); {
+		label, width := t.symbol(prefix, i)
+		c, ok := n.child(label)
+		if !ok {
+			return
+		}
+		key = t.appendSymbol(key, label)
+		n = n.children[c].node
+		i += width
+	}
+	t.walk(n, key, fn)
+}
+
+// walk visits the keys below n in order and reports whether the walk should continue.
+func (t *Trie[V]) walk(n *trieNode[V], key []byte, fn func(key string, value V) bool) bool {
+	if n.hasValue && !fn(string(key), n.value) {
+		return false
+	}
+	for _, edge := range n.children {
+		if !t.walk(edge.node, t.appendSymbol(key, edge.label), fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// LongestPrefixOf returns the longest key in the Trie that is a prefix of s, along with its value.
+// The boolean result is false if no key is a prefix of s.
+//
+// Example:
+//  routes := NewTrie[string]()
+//  routes.Insert("/api", "api")
+//  routes.Insert("/api/users", "users")
+//  key, value, _ := routes.LongestPrefixOf("/api/users/42")
+//  fmt.Println(key, value) // Output: /api/users users
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	n := &t.root
+	end, found := 0, n.hasValue
+	value := n.value
+	for i := 0; i < len(s); {
+		label, width := t.symbol(s, i)
+		c, ok := n.child(label)
+		if !ok {
+			break
+		}
+		n = n.children[c].node
+		i += width
+		if n.hasValue {
+			end, found, value = i, true, n.value
+		}
+	}
+	return s[:end], value, found
+}
+
+// Count returns the number of keys in the Trie.
+func (t *Trie[V]) Count() int {
+	return t.count
+}
+
+// Clear removes all keys from the Trie.
+func (t *Trie[V]) Clear() {
+	t.root = trieNode[V]{}
+	t.count = 0
+}
@@ -0,0 +1,180 @@
+package collections
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTrie(t *testing.T) {
+	trie := NewTrie[int]()
+	words := []string{"tea", "ten", "to", "inn", "in", "i", "team", ""}
+	for i, w := range words {
+		if !trie.Insert(w, i) {
+			t.Errorf("Insert(%q) = false; want true", w)
+		}
+	}
+	if trie.Insert("ten", 10) {
+		t.Error("Insert(ten) again = true; want false")
+	}
+	if count := trie.Count(); count != len(words) {
+		t.Errorf("Count() = %d; want %d", count, len(words))
+	}
+
+	if v, ok := trie.Get("ten"); !ok || v != 10 {
+		t.Errorf("Get(ten) = %d, %v; want 10, true", v, ok)
+	}
+	if _, ok := trie.Get("te"); ok {
+		t.Error("Get(te) found a value for a bare prefix")
+	}
+	if !trie.HasPrefix("te") || trie.HasPrefix("tx") {
+		t.Error("HasPrefix() returned the wrong result")
+	}
+
+	assertSliceEqual(t, trie.KeysWithPrefix("te", 0), []string{"tea", "team", "ten"})
+	assertSliceEqual(t, trie.KeysWithPrefix("", 4), []string{"", "i", "in", "inn"})
+	if keys := trie.KeysWithPrefix("x", 0); len(keys) != 0 {
+		t.Errorf("KeysWithPrefix(x) = %v; want []", keys)
+	}
+
+	if key, v, ok := trie.LongestPrefixOf("teammate"); !ok || key != "team" || v != 6 {
+		t.Errorf("LongestPrefixOf(teammate) = %q, %d, %v; want team, 6, true", key, v, ok)
+	}
+	if key, _, ok := trie.LongestPrefixOf("zebra"); !ok || key != "" {
+		t.Errorf("LongestPrefixOf(zebra) = %q, %v; want \"\", true", key, ok)
+	}
+
+	if !trie.Delete("team") || trie.Delete("team") || trie.Delete("te") {
+		t.Error("Delete() returned the wrong result")
+	}
+	if !trie.Delete("tea") || !trie.Delete("ten") {
+		t.Error("Delete() = false for an existing key")
+	}
+	if trie.HasPrefix("te") {
+		t.Error("HasPrefix(te) = true after deleting every key below it")
+	}
+	if key, _, _ := trie.LongestPrefixOf("teammate"); key != "" {
+		t.Errorf("LongestPrefixOf(teammate) = %q after Delete; want \"\"", key)
+	}
+
+	trie.Clear()
+	if trie.Count() != 0 || trie.HasPrefix("") {
+		t.Error("Clear() left keys behind")
+	}
+}
+
+func TestTrie_WalkPrefix(t *testing.T) {
+	trie := NewTrie[int]()
+	for i, w := range []string{"b", "a", "ab", "abc", "abd", "ac"} {
+		trie.Insert(w, i)
+	}
+
+	var visited []string
+	trie.WalkPrefix("a", func(key string, _ int) bool {
+		visited = append(visited, key)
+		return key != "abc"
+	})
+	assertSliceEqual(t, visited, []string{"a", "ab", "abc"})
+}
+
+func TestTrie_Unicode(t *testing.T) {
+	words := []string{"日本", "日本語", "日曜日", "naïve", "nai", "\xff\xfe"}
+	for _, trie := range []*Trie[bool]{NewTrie[bool](), NewByteTrie[bool]()} {
+		for _, w := range words {
+			trie.Insert(w, true)
+		}
+
+		assertSliceEqual(t, trie.KeysWithPrefix("日", 0), []string{"日曜日", "日本", "日本語"})
+		assertSliceEqual(t, trie.KeysWithPrefix("na", 0), []string{"nai", "naïve"})
+		if key, _, ok := trie.LongestPrefixOf("日本語です"); !ok || key != "日本語" {
+			t.Errorf("LongestPrefixOf() = %q, %v; want 日本語, true", key, ok)
+		}
+	}
+
+	bytes := NewByteTrie[bool]()
+	bytes.Insert("\xff\xfe", true)
+	if !bytes.ContainsKey("\xff\xfe") || bytes.ContainsKey("\xff\xff") {
+		t.Error("a byte trie must distinguish invalid UTF-8 keys")
+	}
+	// A byte trie can also match a prefix that ends inside a multi-byte rune.
+	bytes.Insert("é", true)
+	if !bytes.HasPrefix("é"[:1]) {
+		t.Error("HasPrefix() did not match a partial byte sequence")
+	}
+}
+
+func TestTrie_InvalidUTF8(t *testing.T) {
+	trie := NewTrie[int]()
+	keys := []string{"\xff", "\xfe", "a\xffb", "a\xfeb", "\xc3", "é", "\uFFFD"}
+	for i, k := range keys {
+		if !trie.Insert(k, i) {
+			t.Errorf("Insert(%q) = false; want true", k)
+		}
+	}
+	if count := trie.Count(); count != len(keys) {
+		t.Errorf("Count() = %d; want %d", count, len(keys))
+	}
+	for i, k := range keys {
+		if v, ok := trie.Get(k); !ok || v != i {
+			t.Errorf("Get(%q) = %v, %v; want %v, true", k, v, ok, i)
+		}
+	}
+	if trie.ContainsKey("\xfd") {
+		t.Error("ContainsKey() of an absent invalid key = true; want false")
+	}
+
+	// Invalid bytes come back unchanged, after the code points at the same position.
+	assertSliceEqual(t, trie.KeysWithPrefix("", 0), []string{"a\xfeb", "a\xffb", "é", "\uFFFD", "\xc3", "\xfe", "\xff"})
+	assertSliceEqual(t, trie.KeysWithPrefix("a\xff", 0), []string{"a\xffb"})
+
+	if !trie.Delete("\xff") || trie.ContainsKey("\xff") || !trie.ContainsKey("\xfe") {
+		t.Error("Delete() of an invalid key must not affect other invalid keys")
+	}
+}
+
+func TestTrie_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	trie := NewTrie[int]()
+	reference := map[string]int{}
+	alphabet := []rune("abé日")
+
+	randomKey := func() string {
+		var b strings.Builder
+		for n := r.Intn(5); n > 0; n-- {
+			b.WriteRune(alphabet[r.Intn(len(alphabet))])
+		}
+		return b.String()
+	}
+
+	for i := 0; i < 5000; i++ {
+		key := randomKey()
+		if r.Intn(3) == 0 {
+			_, existed := reference[key]
+			if trie.Delete(key) != existed {
+				t.Fatalf("Delete(%q) != %v", key, existed)
+			}
+			delete(reference, key)
+		} else {
+			trie.Insert(key, i)
+			reference[key] = i
+		}
+	}
+
+	if trie.Count() != len(reference) {
+		t.Fatalf("Count() = %d; want %d", trie.Count(), len(reference))
+	}
+	for _, prefix := range []string{"", "a", "é日", "日b"} {
+		var want []string
+		for key := range reference {
+			if strings.HasPrefix(key, prefix) {
+				want = append(want, key)
+			}
+		}
+		sort.Strings(want)
+		assertSliceEqual(t, trie.KeysWithPrefix(prefix, 0), want)
+		if trie.HasPrefix(prefix) != (len(want) > 0) {
+			t.Errorf("HasPrefix(%q) = %v; want %v", prefix, !(len(want) > 0), len(want) > 0)
+		}
+	}
+}